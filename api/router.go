@@ -20,13 +20,30 @@ func SetupRouter(publicKey *ecdsa.PublicKey) *gin.Engine {
 	api := r.Group("/api/v1")
 	{
 		public := api.Group("")
+		public.Use(middleware.OptionalAuth(publicKey))
 		{
             public.GET("/users", v1.GetUsers)
             public.GET("/users/:username", v1.GetUserByUsername)
             public.GET("/users/:username/github", v1.GetGitHubData)
+            public.GET("/users/:username/github/years", v1.GetGitHubYears)
+            public.GET("/users/:username/github/open-source", v1.GetGitHubOpenSource)
             public.GET("/users/:username/leetcode", v1.GetLeetCodeData)
+            public.GET("/users/:username/leetcode/contests", v1.GetLeetCodeContests)
+            public.GET("/users/:username/codeforces", v1.GetCodeforcesData)
+            public.GET("/users/:username/codeforces/rating", v1.GetCodeforcesRating)
+            public.GET("/users/:username/gitlab", v1.GetGitLabData)
+            public.GET("/users/:username/gitlab/projects", v1.GetGitLabProjects)
+            public.GET("/users/:username/stackoverflow", v1.GetStackOverflowData)
+            public.GET("/users/:username/activity", v1.GetActivityCalendar)
+            public.GET("/users/:username/stats/activity", v1.GetActivityStats)
+            public.GET("/users/:username/wakatime", v1.GetWakaTimeData)
             public.GET("/users/:username/followers", v1.GetFollowers)
             public.GET("/users/:username/following", v1.GetFollowing)
+            public.GET("/users/:username/mutuals", v1.GetMutualFollows)
+            public.GET("/users/:username/common-connections/:other", v1.GetCommonConnections)
+            public.GET("/users/:username/projects", v1.GetUserProjects)
+            public.GET("/projects/:id/stargazers", v1.GetStargazers)
+            public.GET("/projects/:id/comments", v1.GetProjectComments)
         }
 
 		protected := api.Group("")
@@ -38,14 +55,34 @@ func SetupRouter(publicKey *ecdsa.PublicKey) *gin.Engine {
             protected.PUT("/users/me", v1.UpdateCurrentUser)
             protected.DELETE("/users/me", v1.DeleteCurrentUser)
             
+            // Account verification
+            protected.POST("/users/me/verifications/:provider", v1.CreateVerification)
+            protected.POST("/users/me/verifications/:provider/check", v1.CheckVerification)
+
+            // Connected accounts
+            protected.PUT("/users/me/wakatime", v1.ConnectWakaTime)
+            protected.DELETE("/users/me/wakatime", v1.DisconnectWakaTime)
+            protected.POST("/users/me/github/oauth", v1.StartGitHubOAuth)
+            protected.POST("/users/me/github/oauth/callback", v1.CompleteGitHubOAuth)
+            protected.DELETE("/users/me/github/oauth", v1.DisconnectGitHubOAuth)
+
             // Skills
             protected.PUT("/users/me/skills", v1.UpdateSkills)
+
+            // Imports
+            protected.POST("/users/me/import/linkedin", v1.ImportLinkedIn)
             
             // Projects
             protected.GET("/users/me/projects", v1.GetMyProjects)
             protected.POST("/users/me/projects", v1.CreateProject)
             protected.PUT("/users/me/projects/:id", v1.UpdateProject)
             protected.DELETE("/users/me/projects/:id", v1.DeleteProject)
+            protected.POST("/users/me/projects/:id/sync", v1.SyncProject)
+            protected.POST("/projects/:id/star", v1.StarProject)
+            protected.DELETE("/projects/:id/star", v1.UnstarProject)
+            protected.POST("/projects/:id/comments", v1.CreateProjectComment)
+            protected.PUT("/projects/:id/comments/:commentId", v1.UpdateProjectComment)
+            protected.DELETE("/projects/:id/comments/:commentId", v1.DeleteProjectComment)
             
             // Education
             protected.GET("/users/me/education", v1.GetMyEducation)
@@ -63,6 +100,50 @@ func SetupRouter(publicKey *ecdsa.PublicKey) *gin.Engine {
             protected.POST("/users/:username/follow", v1.FollowUser)
             protected.DELETE("/users/:username/follow", v1.UnfollowUser)
             protected.GET("/users/me/following/:username", v1.CheckFollowStatus)
+            protected.GET("/users/me/recommendations", v1.GetFollowSuggestions)
+            protected.GET("/users/:username/known-followers", v1.GetKnownFollowers)
+            protected.GET("/users/me/follow-requests", v1.GetFollowRequests)
+            protected.POST("/users/me/follow-requests/:id/approve", v1.ApproveFollowRequest)
+            protected.POST("/users/me/follow-requests/:id/deny", v1.DenyFollowRequest)
+
+            // Blocks and mutes
+            protected.GET("/users/me/blocks", v1.GetBlockedUsers)
+            protected.POST("/users/me/blocks/:username", v1.BlockUser)
+            protected.DELETE("/users/me/blocks/:username", v1.UnblockUser)
+            protected.GET("/users/me/mutes", v1.GetMutedUsers)
+            protected.POST("/users/me/mutes/:username", v1.MuteUser)
+            protected.DELETE("/users/me/mutes/:username", v1.UnmuteUser)
+
+            // Endorsements
+            protected.POST("/users/:username/endorsements", v1.EndorseSkill)
+            protected.DELETE("/users/:username/endorsements", v1.RetractEndorsement)
+
+            // Feed
+            protected.GET("/feed", v1.GetFeed)
+
+            // Messages
+            protected.GET("/conversations", v1.GetConversations)
+            protected.POST("/conversations", v1.StartConversation)
+            protected.GET("/conversations/:id/messages", v1.GetMessages)
+            protected.POST("/conversations/:id/messages", v1.SendMessage)
+            protected.POST("/conversations/:id/read", v1.MarkConversationRead)
+
+            // Recommendations
+            protected.POST("/recommendations", v1.RequestRecommendation)
+            protected.GET("/recommendations/received", v1.GetReceivedRecommendations)
+            protected.GET("/recommendations/given", v1.GetGivenRecommendations)
+            protected.PUT("/recommendations/:id", v1.WriteRecommendation)
+            protected.DELETE("/recommendations/:id", v1.DeleteRecommendation)
+            protected.POST("/recommendations/:id/approve", v1.ApproveRecommendation)
+            protected.POST("/recommendations/:id/hide", v1.HideRecommendation)
+
+            // Notifications
+            protected.GET("/notifications", v1.GetNotifications)
+            protected.GET("/notifications/unread-count", v1.GetUnreadNotificationCount)
+            protected.POST("/notifications/read-all", v1.MarkAllNotificationsRead)
+            protected.POST("/notifications/:id/read", v1.MarkNotificationRead)
+            protected.GET("/notifications/preferences", v1.GetNotificationPreferences)
+            protected.PUT("/notifications/preferences", v1.UpdateNotificationPreferences)
         }
 	}
 
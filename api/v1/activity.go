@@ -0,0 +1,165 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/services"
+)
+
+// GetActivityCalendar godoc
+// @Summary Get unified activity calendar
+// @Description Returns a trailing-year activity heatmap merging every linked source (GitHub, GitLab, LeetCode, Codeforces), with a per-source breakdown for each day. Sources that cannot be reached are listed in unavailableSources instead of failing the request.
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Param include query string false "Comma-separated sources to include" example(github,leetcode)
+// @Param exclude query string false "Comma-separated sources to exclude" example(codeforces)
+// @Param tz query string false "IANA time zone, e.g. Europe/Berlin"
+// @Success 200 {object} services.ActivityCalendar
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/activity [get]
+func GetActivityCalendar(c *gin.Context) {
+	calendar, _, ok := fetchActivityCalendar(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, calendar)
+}
+
+// GetActivityStats godoc
+// @Summary Get coding activity statistics
+// @Description Returns streaks, active days, the busiest weekday and rolling averages over the trailing year, computed from the unified activity calendar. Days are evaluated in the user's time zone unless tz is given.
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Param include query string false "Comma-separated sources to include" example(github,leetcode)
+// @Param exclude query string false "Comma-separated sources to exclude" example(codeforces)
+// @Param tz query string false "IANA time zone, e.g. Europe/Berlin"
+// @Success 200 {object} services.ActivityStats
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/stats/activity [get]
+func GetActivityStats(c *gin.Context) {
+	calendar, loc, ok := fetchActivityCalendar(c)
+	if !ok {
+		return
+	}
+
+	stats := services.ComputeActivityStats(calendar.Counts(), time.Now().In(loc))
+	stats.Sources = calendar.Sources
+	stats.UnavailableSources = calendar.UnavailableSources
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// fetchActivityCalendar loads the user named in the path and builds their
+// activity calendar from the include, exclude and tz query parameters,
+// returning it with the time zone it was built in. It writes the error
+// response and returns false if anything fails.
+func fetchActivityCalendar(c *gin.Context) (*services.ActivityCalendar, *time.Location, bool) {
+	username := c.Param("username")
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return nil, nil, false
+	}
+
+	timeZone := c.Query("tz")
+	if timeZone == "" {
+		timeZone = services.StringValue(user.TimeZone)
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "Local" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time zone"})
+		return nil, nil, false
+	}
+
+	sources, err := selectActivitySources(c.Query("include"), c.Query("exclude"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, nil, false
+	}
+
+	linked := make(map[string]bool)
+	for _, source := range services.LinkedActivitySources(user) {
+		linked[source] = true
+	}
+	var requested []string
+	for _, source := range sources {
+		if linked[source] {
+			requested = append(requested, source)
+		}
+	}
+	if len(requested) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected any of the requested activity sources"})
+		return nil, nil, false
+	}
+
+	calendar, err := services.GetActivityCalendar(c.Request.Context(), user, requested, loc)
+	if err != nil {
+		respondExternalError(c, err)
+		return nil, nil, false
+	}
+
+	return calendar, loc, true
+}
+
+// selectActivitySources resolves comma-separated include and exclude lists
+// into the activity sources to fetch. An empty include means all sources.
+func selectActivitySources(include, exclude string) ([]string, error) {
+	known := make(map[string]bool)
+	for _, source := range services.ActivitySources {
+		known[source] = true
+	}
+
+	parse := func(list string) (map[string]bool, error) {
+		selected := make(map[string]bool)
+		for _, source := range strings.Split(list, ",") {
+			source = strings.ToLower(strings.TrimSpace(source))
+			if source == "" {
+				continue
+			}
+			if !known[source] {
+				return nil, fmt.Errorf("unknown activity source %q, must be one of %s",
+					source, strings.Join(services.ActivitySources, ", "))
+			}
+			selected[source] = true
+		}
+		return selected, nil
+	}
+
+	included, err := parse(include)
+	if err != nil {
+		return nil, err
+	}
+	excluded, err := parse(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []string
+	for _, source := range services.ActivitySources {
+		if (len(included) == 0 || included[source]) && !excluded[source] {
+			sources = append(sources, source)
+		}
+	}
+	return sources, nil
+}
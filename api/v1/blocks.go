@@ -0,0 +1,240 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+// errAlreadyBlocked lets the block transaction report a no-op without it
+// being treated as a failure
+var errAlreadyBlocked = errors.New("user already blocked")
+
+type BlocksResponse struct {
+	Users []db.User `json:"users"`
+	Total int64     `json:"total"`
+	Page  int       `json:"page"`
+	Limit int       `json:"limit"`
+}
+
+// GetBlockedUsers godoc
+// @Summary Get blocked users
+// @Description Returns a paginated list of users the authenticated user has blocked, most recent first
+// @Tags Blocks
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} BlocksResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/blocks [get]
+func GetBlockedUsers(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	var total int64
+	db.GetDB().Model(&db.Block{}).Where("blocker_id = ?", userId).Count(&total)
+
+	var blocks []db.Block
+	if err := db.GetDB().Where("blocker_id = ?", userId).Preload("Blocked").
+		Order("created_at DESC").Offset(offset).Limit(limit).Find(&blocks).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch blocked users"})
+		return
+	}
+
+	users := make([]db.User, 0, len(blocks))
+	for _, block := range blocks {
+		users = append(users, block.Blocked)
+	}
+
+	c.JSON(http.StatusOK, BlocksResponse{
+		Users: users,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	})
+}
+
+// BlockUser godoc
+// @Summary Block a user
+// @Description Blocks a user. Any follows or follow requests between the two users are removed, along with endorsements and recommendations either has given the other. Neither user can follow, endorse or interact with the other's projects while the block stands, and each is hidden from the other's searches, profiles, projects, follow lists, conversations and suggestions.
+// @Tags Blocks
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the user to block"
+// @Success 201 {object} db.Block
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/blocks/{username} [post]
+func BlockUser(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	username := c.Param("username")
+	var user db.User
+	result := db.GetDB().Where("username = ?", username).First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+
+	if user.Id == userId.(string) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot block yourself"})
+		return
+	}
+
+	block := db.Block{
+		BlockerId: userId.(string),
+		BlockedId: user.Id,
+	}
+
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&db.Block{}).Where("blocker_id = ? AND blocked_id = ?", block.BlockerId, block.BlockedId).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errAlreadyBlocked
+		}
+
+		if err := tx.Create(&block).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)",
+			block.BlockerId, block.BlockedId, block.BlockedId, block.BlockerId).Delete(&db.Follow{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("(requester_id = ? AND target_id = ?) OR (requester_id = ? AND target_id = ?)",
+			block.BlockerId, block.BlockedId, block.BlockedId, block.BlockerId).Delete(&db.FollowRequest{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("(user_id = ? AND endorser_id = ?) OR (user_id = ? AND endorser_id = ?)",
+			block.BlockerId, block.BlockedId, block.BlockedId, block.BlockerId).Delete(&db.Endorsement{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("(user_id = ? AND recommender_id = ?) OR (user_id = ? AND recommender_id = ?)",
+			block.BlockerId, block.BlockedId, block.BlockedId, block.BlockerId).Delete(&db.Recommendation{}).Error
+	})
+	if err != nil {
+		if errors.Is(err, errAlreadyBlocked) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Already blocking this user"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to block user"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, block)
+}
+
+// UnblockUser godoc
+// @Summary Unblock a user
+// @Description Removes the authenticated user's block of a user. Follows removed by the block are not restored.
+// @Tags Blocks
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the user to unblock"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/blocks/{username} [delete]
+func UnblockUser(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	username := c.Param("username")
+	var user db.User
+	result := db.GetDB().Where("username = ?", username).First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+
+	deleted := db.GetDB().Where("blocker_id = ? AND blocked_id = ?", userId, user.Id).Delete(&db.Block{})
+	if deleted.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unblock user"})
+		return
+	}
+	if deleted.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not blocking this user"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User unblocked successfully"})
+}
+
+// isBlocked reports whether either user has blocked the other
+func isBlocked(userId, otherId string) (bool, error) {
+	var count int64
+	err := db.GetDB().Model(&db.Block{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userId, otherId, otherId, userId).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// hiddenByBlock reports whether the request's viewer, if there is one, and
+// the user have blocked each other in either direction
+func hiddenByBlock(c *gin.Context, userId string) (bool, error) {
+	viewerId, ok := c.Get("userId")
+	if !ok {
+		return false, nil
+	}
+	return isBlocked(viewerId.(string), userId)
+}
+
+// blockedIdsOf selects the ids of the users userId has blocked or been
+// blocked by
+func blockedIdsOf(userId string) *gorm.DB {
+	return db.GetDB().Raw("SELECT blocked_id FROM blocks WHERE blocker_id = ? UNION SELECT blocker_id FROM blocks WHERE blocked_id = ?",
+		userId, userId)
+}
+
+// hideBlocked leaves out rows whose column names a user the request's viewer
+// has blocked or been blocked by. Anonymous requests are left unfiltered.
+func hideBlocked(c *gin.Context, query *gorm.DB, column string) *gorm.DB {
+	viewerId, ok := c.Get("userId")
+	if !ok {
+		return query
+	}
+	return query.Where(column+" NOT IN (?)", blockedIdsOf(viewerId.(string)))
+}
@@ -0,0 +1,278 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+// topEndorsersPerSkill is the number of endorsers shown for each skill
+const topEndorsersPerSkill = 3
+
+// EndorseSkillRequest represents the request body for endorsing a skill
+type EndorseSkillRequest struct {
+	Skill string `json:"skill" binding:"required" example:"Go"`
+}
+
+// EndorseSkill godoc
+// @Summary Endorse a skill
+// @Description Endorses one of the skills a user lists on their profile. Each skill can be endorsed once per endorser, and users cannot endorse themselves.
+// @Tags Endorsements
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the user to endorse"
+// @Param request body EndorseSkillRequest true "Skill to endorse"
+// @Success 201 {object} db.Endorsement
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/{username}/endorsements [post]
+func EndorseSkill(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req EndorseSkillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, ok := findUserByUsername(c, c.Param("username"))
+	if !ok {
+		return
+	}
+
+	if user.Id == userId.(string) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot endorse yourself"})
+		return
+	}
+
+	visible, err := canViewProfile(c, user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check endorsement"})
+		return
+	}
+	if !visible {
+		c.JSON(http.StatusForbidden, gin.H{"error": "This profile is private"})
+		return
+	}
+
+	skill, ok := listedSkill(user, req.Skill)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "User does not list this skill"})
+		return
+	}
+
+	var existing db.Endorsement
+	err = db.GetDB().Where("user_id = ? AND endorser_id = ? AND LOWER(skill) = LOWER(?)", user.Id, userId, skill).
+		First(&existing).Error
+	if err == nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "Already endorsed this skill"})
+		return
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check endorsement"})
+		return
+	}
+
+	endorsement := db.Endorsement{
+		UserId:     user.Id,
+		EndorserId: userId.(string),
+		Skill:      skill,
+	}
+	if err := db.GetDB().Create(&endorsement).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to endorse skill"})
+		return
+	}
+
+	notify(user.Id, endorsement.EndorserId, db.NotificationEndorsement, &endorsement.Id)
+
+	c.JSON(http.StatusCreated, endorsement)
+}
+
+// RetractEndorsement godoc
+// @Summary Retract an endorsement
+// @Description Removes the authenticated user's endorsement of one of a user's skills
+// @Tags Endorsements
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the endorsed user"
+// @Param skill query string true "Endorsed skill"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/{username}/endorsements [delete]
+func RetractEndorsement(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	skill := strings.TrimSpace(c.Query("skill"))
+	if skill == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "skill is required"})
+		return
+	}
+
+	username := c.Param("username")
+	var user db.User
+	result := db.GetDB().Where("username = ?", username).First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+
+	var endorsement db.Endorsement
+	result = db.GetDB().Where("user_id = ? AND endorser_id = ? AND LOWER(skill) = LOWER(?)", user.Id, userId, skill).
+		First(&endorsement)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Not endorsing this skill"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch endorsement"})
+		return
+	}
+
+	if err := db.GetDB().Delete(&endorsement).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retract endorsement"})
+		return
+	}
+
+	withdrawNotification(user.Id, endorsement.EndorserId, db.NotificationEndorsement, &endorsement.Id)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Endorsement retracted successfully"})
+}
+
+// listedSkill returns the user's own spelling of a skill they list, matched
+// case-insensitively
+func listedSkill(user *db.User, skill string) (string, bool) {
+	skill = strings.TrimSpace(skill)
+	for _, listed := range user.Skills {
+		if strings.EqualFold(listed, skill) {
+			return listed, true
+		}
+	}
+	return "", false
+}
+
+// loadEndorsements fills in the endorsement count and top endorsers of each
+// endorsed skill the user lists, most endorsed first. Top endorsers are the
+// endorsers with the most followers, leaving out any the viewer has blocked
+// or been blocked by.
+func loadEndorsements(c *gin.Context, user *db.User) error {
+	var counts []struct {
+		Skill string
+		Count int64
+	}
+	if err := db.GetDB().Model(&db.Endorsement{}).Select("LOWER(skill) AS skill, COUNT(*) AS count").
+		Where("user_id = ?", user.Id).Group("LOWER(skill)").Scan(&counts).Error; err != nil {
+		return err
+	}
+
+	endorsements := hideBlocked(c, db.GetDB().Model(&db.Endorsement{}).Where("user_id = ?", user.Id), "endorser_id")
+
+	var ranked []struct {
+		Skill      string
+		EndorserId string
+	}
+	if err := db.GetDB().Raw(`
+		SELECT skill, endorser_id FROM (
+			SELECT LOWER(e.skill) AS skill, e.endorser_id,
+				ROW_NUMBER() OVER (PARTITION BY LOWER(e.skill) ORDER BY COUNT(f.id) DESC, e.created_at ASC) AS rank
+			FROM (?) e
+			LEFT JOIN follows f ON f.following_id = e.endorser_id
+			GROUP BY e.id, e.skill, e.endorser_id, e.created_at
+		) ranked
+		WHERE rank <= ?
+		ORDER BY skill, rank`, endorsements, topEndorsersPerSkill).Scan(&ranked).Error; err != nil {
+		return err
+	}
+
+	endorserIds := make([]string, 0, len(ranked))
+	for _, row := range ranked {
+		endorserIds = append(endorserIds, row.EndorserId)
+	}
+	var endorsers []db.User
+	if len(endorserIds) > 0 {
+		if err := db.GetDB().Where("id IN ?", endorserIds).Find(&endorsers).Error; err != nil {
+			return err
+		}
+		if err := redactPrivateUsers(c, endorsers); err != nil {
+			return err
+		}
+	}
+	byId := make(map[string]db.User, len(endorsers))
+	for _, endorser := range endorsers {
+		byId[endorser.Id] = endorser
+	}
+
+	top := make(map[string][]db.User)
+	for _, count := range counts {
+		top[count.Skill] = []db.User{}
+	}
+	for _, row := range ranked {
+		if endorser, ok := byId[row.EndorserId]; ok {
+			top[row.Skill] = append(top[row.Skill], endorser)
+		}
+	}
+
+	user.Endorsements = []db.SkillEndorsements{}
+	for _, count := range counts {
+		// Endorsements are removed with the skill, so every skill should
+		// still be listed, but the profile's spelling is the one shown
+		skill, ok := listedSkill(user, count.Skill)
+		if !ok {
+			continue
+		}
+		user.Endorsements = append(user.Endorsements, db.SkillEndorsements{
+			Skill:        skill,
+			Count:        count.Count,
+			TopEndorsers: top[count.Skill],
+		})
+	}
+
+	sort.Slice(user.Endorsements, func(i, j int) bool {
+		a, b := user.Endorsements[i], user.Endorsements[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Skill < b.Skill
+	})
+
+	return nil
+}
+
+// removeOrphanedEndorsements deletes endorsements of skills the user no
+// longer lists
+func removeOrphanedEndorsements(tx *gorm.DB, userId string, skills []string) error {
+	query := tx.Where("user_id = ?", userId)
+	if len(skills) > 0 {
+		lowered := make([]string, len(skills))
+		for i, skill := range skills {
+			lowered[i] = strings.ToLower(skill)
+		}
+		query = query.Where("LOWER(skill) NOT IN ?", lowered)
+	}
+	return query.Delete(&db.Endorsement{}).Error
+}
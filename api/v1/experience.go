@@ -117,6 +117,15 @@ func CreateExperience(c *gin.Context) {
 		return
 	}
 
+	// Only a current position is news; past ones are just profile backfill
+	if experience.IsCurrent {
+		recordEvent(experience.UserId, db.EventNewRole, db.EventPayload{
+			"experienceId": experience.Id,
+			"company":      experience.Company,
+			"title":        experience.Title,
+		})
+	}
+
 	c.JSON(http.StatusCreated, experience)
 }
 
@@ -211,7 +220,7 @@ func UpdateExperience(c *gin.Context) {
 
 // DeleteExperience godoc
 // @Summary Delete experience
-// @Description Deletes an experience entry
+// @Description Deletes an experience entry. Recommendations about it are kept without it.
 // @Tags Experience
 // @Accept json
 // @Produce json
@@ -231,16 +240,33 @@ func DeleteExperience(c *gin.Context) {
 
 	experienceId := c.Param("id")
 
-	result := db.GetDB().Where("id = ? AND user_id = ?", experienceId, userId).Delete(&db.Experience{})
-	if result.Error != nil {
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		// Recommendations about the role stay on the profile without it. They
+		// are detached first so the experience's foreign key allows the delete.
+		if err := tx.Model(&db.Recommendation{}).Where("experience_id = ? AND user_id = ?", experienceId, userId).
+			UpdateColumn("experience_id", nil).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ? AND user_id = ?", experienceId, userId).Delete(&db.Experience{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Experience not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete experience"})
 		return
 	}
 
-	if result.RowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Experience not found"})
-		return
-	}
+	removeEvents(userId.(string), db.EventNewRole, "experienceId", experienceId)
 
 	c.JSON(http.StatusOK, gin.H{"message": "Experience deleted successfully"})
 }
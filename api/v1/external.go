@@ -3,36 +3,88 @@ package v1
 import (
 	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/httpclient"
 	"github.com/ryanmello/devboard/services"
-	"gorm.io/gorm"
 )
 
+// GitHubYearsResponse lists the years in which a user has GitHub contributions
+type GitHubYearsResponse struct {
+	Years []int `json:"years" example:"2025,2024,2023"`
+}
+
 // GetGitHubData godoc
 // @Summary Get GitHub contribution data
-// @Description Returns GitHub contribution calendar data for a user
+// @Description Returns GitHub contribution calendar data for a user. Defaults to the trailing year; pass either year or from and to (at most one year apart) for historical calendars.
 // @Tags External
 // @Accept json
 // @Produce json
 // @Param username path string true "Username"
+// @Param year query int false "Calendar year"
+// @Param from query string false "Range start (YYYY-MM-DD or RFC3339)"
+// @Param to query string false "Range end (YYYY-MM-DD or RFC3339)"
 // @Success 200 {object} services.GitHubContributionData
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
 // @Router /users/{username}/github [get]
 func GetGitHubData(c *gin.Context) {
 	username := c.Param("username")
 
-	// Get user from database to find their GitHub username
-	var user db.User
-	result := db.GetDB().Where("username = ?", username).First(&user)
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	yearParam := c.Query("year")
+	fromParam := c.Query("from")
+	toParam := c.Query("to")
+
+	if yearParam != "" && (fromParam != "" || toParam != "") {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Use either year or from and to, not both"})
+		return
+	}
+	if (fromParam == "") != (toParam == "") {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Both from and to are required for a date range"})
+		return
+	}
+
+	var year int
+	if yearParam != "" {
+		var err error
+		year, err = strconv.Atoi(yearParam)
+		if err != nil || year < 2008 || year > time.Now().Year() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid year"})
+			return
+		}
+	}
+
+	var from, to time.Time
+	if fromParam != "" {
+		var err error
+		if from, err = parseDateParam(fromParam, false); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from date"})
+			return
+		}
+		if to, err = parseDateParam(toParam, true); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to date"})
+			return
+		}
+		if !to.After(from) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to must be after from"})
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		if to.After(from.AddDate(1, 0, 0)) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Date range must not exceed one year"})
+			return
+		}
+	}
+
+	// Get user from database to find their GitHub username
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
 		return
 	}
 
@@ -43,16 +95,141 @@ func GetGitHubData(c *gin.Context) {
 	}
 
 	// Fetch GitHub contribution data
-	githubService := services.NewGitHubService()
-	data, err := githubService.GetContributions(*user.GitHubUsername)
+	githubService := services.GitHubServiceForUser(c.Request.Context(), user.Id)
+
+	var data *services.GitHubContributionData
+	var err error
+	switch {
+	case year != 0:
+		data, err = githubService.GetContributionsForYear(c.Request.Context(), *user.GitHubUsername, year)
+	case fromParam != "":
+		data, err = githubService.GetContributionsInRange(c.Request.Context(), *user.GitHubUsername, from, to)
+	default:
+		data, err = githubService.GetContributions(c.Request.Context(), *user.GitHubUsername)
+	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondExternalError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, data)
 }
 
+// GetGitHubYears godoc
+// @Summary Get GitHub contribution years
+// @Description Returns the years in which a user has GitHub contributions, most recent first
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} GitHubYearsResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/github/years [get]
+func GetGitHubYears(c *gin.Context) {
+	username := c.Param("username")
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	if user.GitHubUsername == nil || *user.GitHubUsername == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a GitHub account"})
+		return
+	}
+
+	githubService := services.GitHubServiceForUser(c.Request.Context(), user.Id)
+	years, err := githubService.GetContributionYears(c.Request.Context(), *user.GitHubUsername)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GitHubYearsResponse{Years: years})
+}
+
+// OpenSourceResponse represents a page of repositories the user has
+// contributed merged pull requests to
+type OpenSourceResponse struct {
+	Repositories      []services.OpenSourceRepository `json:"repositories"`
+	Total             int                             `json:"total" example:"12"`
+	TotalPullRequests int                             `json:"totalPullRequests" example:"37"`
+	Page              int                             `json:"page" example:"1"`
+	Limit             int                             `json:"limit" example:"20"`
+}
+
+// GetGitHubOpenSource godoc
+// @Summary Get GitHub open-source contributions
+// @Description Returns the repositories owned by others that a user has merged pull requests into, most-starred first, with their most recent merged pull requests
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Repositories per page" default(20)
+// @Success 200 {object} OpenSourceResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/github/open-source [get]
+func GetGitHubOpenSource(c *gin.Context) {
+	username := c.Param("username")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	if user.GitHubUsername == nil || *user.GitHubUsername == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a GitHub account"})
+		return
+	}
+
+	githubService := services.GitHubServiceForUser(c.Request.Context(), user.Id)
+	contributions, err := githubService.GetOpenSourceContributions(c.Request.Context(), *user.GitHubUsername)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	repos := contributions.Repositories
+	// Pages past the end are compared before multiplying so a huge page
+	// number cannot overflow into a negative offset
+	start := len(repos)
+	if page-1 < (len(repos)+limit-1)/limit {
+		start = (page - 1) * limit
+	}
+	end := start + limit
+	if end > len(repos) {
+		end = len(repos)
+	}
+
+	c.JSON(http.StatusOK, OpenSourceResponse{
+		Repositories:      repos[start:end],
+		Total:             len(repos),
+		TotalPullRequests: contributions.TotalPullRequests,
+		Page:              page,
+		Limit:             limit,
+	})
+}
+
 // GetLeetCodeData godoc
 // @Summary Get LeetCode statistics
 // @Description Returns LeetCode problem-solving statistics for a user
@@ -61,21 +238,19 @@ func GetGitHubData(c *gin.Context) {
 // @Produce json
 // @Param username path string true "Username"
 // @Success 200 {object} services.LeetCodeStats
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
 // @Router /users/{username}/leetcode [get]
 func GetLeetCodeData(c *gin.Context) {
 	username := c.Param("username")
 
 	// Get user from database to find their LeetCode username
-	var user db.User
-	result := db.GetDB().Where("username = ?", username).First(&user)
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
 		return
 	}
 
@@ -87,11 +262,281 @@ func GetLeetCodeData(c *gin.Context) {
 
 	// Fetch LeetCode statistics
 	leetcodeService := services.NewLeetCodeService()
-	data, err := leetcodeService.GetStats(*user.LeetCodeUsername)
+	data, err := leetcodeService.GetStats(c.Request.Context(), *user.LeetCodeUsername)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetLeetCodeContests godoc
+// @Summary Get LeetCode contest history
+// @Description Returns LeetCode contest rating, global ranking, rating history and earned badges for a user
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} services.LeetCodeContestStats
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/leetcode/contests [get]
+func GetLeetCodeContests(c *gin.Context) {
+	username := c.Param("username")
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	if user.LeetCodeUsername == nil || *user.LeetCodeUsername == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a LeetCode account"})
+		return
+	}
+
+	leetcodeService := services.NewLeetCodeService()
+	data, err := leetcodeService.GetContests(c.Request.Context(), *user.LeetCodeUsername)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondExternalError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, data)
 }
+
+// GetCodeforcesData godoc
+// @Summary Get Codeforces statistics
+// @Description Returns current and max rating, rank and solved-problem counts by difficulty for a user's Codeforces account
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} services.CodeforcesStats
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/codeforces [get]
+func GetCodeforcesData(c *gin.Context) {
+	username := c.Param("username")
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	if user.CodeforcesHandle == nil || *user.CodeforcesHandle == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a Codeforces account"})
+		return
+	}
+
+	codeforcesService := services.NewCodeforcesService()
+	data, err := codeforcesService.GetStats(c.Request.Context(), *user.CodeforcesHandle)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetCodeforcesRating godoc
+// @Summary Get Codeforces rating history
+// @Description Returns the rating change from every rated Codeforces contest a user has taken part in, oldest first
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {array} services.CodeforcesRatingChange
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/codeforces/rating [get]
+func GetCodeforcesRating(c *gin.Context) {
+	username := c.Param("username")
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	if user.CodeforcesHandle == nil || *user.CodeforcesHandle == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a Codeforces account"})
+		return
+	}
+
+	codeforcesService := services.NewCodeforcesService()
+	history, err := codeforcesService.GetRatingHistory(c.Request.Context(), *user.CodeforcesHandle)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// GetGitLabData godoc
+// @Summary Get GitLab contribution data
+// @Description Returns the GitLab contribution calendar for a user in the same shape as GitHub contribution data. Self-hosted instances are supported through the user's GitLab URL.
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} services.GitHubContributionData
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/gitlab [get]
+func GetGitLabData(c *gin.Context) {
+	username := c.Param("username")
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	if user.GitLabUsername == nil || *user.GitLabUsername == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a GitLab account"})
+		return
+	}
+
+	gitlabService := services.NewGitLabService(services.StringValue(user.GitLabURL))
+	data, err := gitlabService.GetContributions(c.Request.Context(), *user.GitLabUsername)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// GetGitLabProjects godoc
+// @Summary Get GitLab projects
+// @Description Returns the public projects owned by a user's GitLab account, most recently active first
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {array} services.GitLabProject
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/gitlab/projects [get]
+func GetGitLabProjects(c *gin.Context) {
+	username := c.Param("username")
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	if user.GitLabUsername == nil || *user.GitLabUsername == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a GitLab account"})
+		return
+	}
+
+	gitlabService := services.NewGitLabService(services.StringValue(user.GitLabURL))
+	projects, err := gitlabService.GetProjects(c.Request.Context(), *user.GitLabUsername)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, projects)
+}
+
+// GetStackOverflowData godoc
+// @Summary Get Stack Overflow statistics
+// @Description Returns reputation, badge counts, top answer tags and top answers for a user's Stack Overflow account
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} services.StackOverflowStats
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/stackoverflow [get]
+func GetStackOverflowData(c *gin.Context) {
+	username := c.Param("username")
+
+	// Get user from database to find their Stack Overflow user id
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	// Check if user has a Stack Overflow account
+	if user.StackOverflowId == nil || *user.StackOverflowId == "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a Stack Overflow account"})
+		return
+	}
+
+	// Fetch Stack Overflow statistics
+	stackOverflowService := services.NewStackOverflowService()
+	data, err := stackOverflowService.GetStats(c.Request.Context(), *user.StackOverflowId)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// parseDateParam parses a YYYY-MM-DD or RFC3339 query value. Plain dates
+// resolve to the start of the day, or the end of the day when endOfDay is set.
+func parseDateParam(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Second)
+	}
+	return t, nil
+}
+
+// respondExternalError writes the response for a failed call to an external
+// service. The underlying error is attached to the context for the request
+// log rather than returned to the client.
+func respondExternalError(c *gin.Context, err error) {
+	_ = c.Error(err)
+
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Account not found on the external service"})
+	case errors.Is(err, services.ErrInvalidCredentials):
+		c.JSON(http.StatusBadGateway, gin.H{"error": "External service rejected the linked credentials"})
+	case errors.Is(err, services.ErrNotConfigured):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "External service integration is not configured"})
+	case errors.Is(err, httpclient.ErrTimeout):
+		c.JSON(http.StatusGatewayTimeout, gin.H{"error": "External service timed out"})
+	case errors.Is(err, httpclient.ErrCircuitOpen), errors.Is(err, httpclient.ErrRateLimited):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "External service is temporarily unavailable"})
+	default:
+		c.JSON(http.StatusBadGateway, gin.H{"error": "External service request failed"})
+	}
+}
@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+)
+
+// followerMilestones are the follower counts announced in the feed
+var followerMilestones = []int64{10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+type FeedResponse struct {
+	Events []db.Event `json:"events"`
+	Total  int64      `json:"total"`
+	Page   int        `json:"page"`
+	Limit  int        `json:"limit"`
+}
+
+// GetFeed godoc
+// @Summary Get activity feed
+// @Description Returns a paginated, newest-first feed of events from the users the authenticated user follows: projects added, new roles, skill updates and follower milestones. Muted users are left out.
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} FeedResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /feed [get]
+func GetFeed(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	// Events are gathered from followed users at read time, using the
+	// (actor_id, created_at) index for each of them. Muted users are still
+	// followed but left out.
+	following := db.GetDB().Model(&db.Follow{}).Select("following_id").
+		Where("follower_id = ? AND following_id NOT IN (?)", userId, mutedIdsOf(userId.(string)))
+
+	var total int64
+	if err := db.GetDB().Model(&db.Event{}).Where("actor_id IN (?)", following).Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch feed"})
+		return
+	}
+
+	events := []db.Event{}
+	if err := db.GetDB().Where("actor_id IN (?)", following).Preload("Actor").Order("created_at DESC, id DESC").
+		Offset(offset).Limit(limit).Find(&events).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch feed"})
+		return
+	}
+
+	c.JSON(http.StatusOK, FeedResponse{
+		Events: events,
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+	})
+}
+
+// recordEvent adds an event to the actor's feed. The change that triggered
+// it has already been saved, so failures are logged rather than returned.
+func recordEvent(actorId, eventType string, payload db.EventPayload) {
+	event := db.Event{
+		ActorId: actorId,
+		Type:    eventType,
+		Payload: payload,
+	}
+	if err := db.GetDB().Create(&event).Error; err != nil {
+		log.Printf("Failed to record %s event for user %s: %v", eventType, actorId, err)
+	}
+}
+
+// removeEvents deletes an actor's events about a record that no longer
+// exists, matched on a payload field
+func removeEvents(actorId, eventType, field, value string) {
+	if err := db.GetDB().Where("actor_id = ? AND type = ? AND payload->>? = ?", actorId, eventType, field, value).
+		Delete(&db.Event{}).Error; err != nil {
+		log.Printf("Failed to remove %s events for user %s: %v", eventType, actorId, err)
+	}
+}
+
+// recordFollowerMilestone records an event when a user's follower count
+// reaches a milestone. Each milestone is only announced once, so losing and
+// regaining a follower around it does not repeat the event.
+func recordFollowerMilestone(userId string) {
+	var followers int64
+	if err := db.GetDB().Model(&db.Follow{}).Where("following_id = ?", userId).Count(&followers).Error; err != nil {
+		log.Printf("Failed to count followers for user %s: %v", userId, err)
+		return
+	}
+
+	for _, milestone := range followerMilestones {
+		if followers != milestone {
+			continue
+		}
+
+		var announced int64
+		value := strconv.FormatInt(milestone, 10)
+		if err := db.GetDB().Model(&db.Event{}).
+			Where("actor_id = ? AND type = ? AND payload->>'followers' = ?", userId, db.EventFollowerMilestone, value).
+			Count(&announced).Error; err != nil {
+			log.Printf("Failed to check follower milestones for user %s: %v", userId, err)
+			return
+		}
+		if announced == 0 {
+			recordEvent(userId, db.EventFollowerMilestone, db.EventPayload{"followers": milestone})
+		}
+		return
+	}
+}
@@ -19,16 +19,19 @@ type FollowResponse struct {
 
 type FollowStatusResponse struct {
 	IsFollowing bool `json:"isFollowing"`
+	FollowsYou  bool `json:"followsYou"`
+	IsRequested bool `json:"isRequested"`
 }
 
 // FollowUser godoc
 // @Summary Follow a user
-// @Description Follow a user by their username
+// @Description Follow a user by their username. Following a private profile sends a follow request instead, which the user must approve.
 // @Tags Follow
 // @Accept json
 // @Produce json
 // @Param username path string true "Username of the user to follow"
 // @Success 201 {object} db.Follow
+// @Success 202 {object} db.FollowRequest
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
@@ -43,16 +46,8 @@ func FollowUser(c *gin.Context) {
 		return
 	}
 
-	username := c.Param("username")
-	var user db.User
-	result := db.GetDB().Where("username = ?", username).First(&user)
-
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+	user, ok := findUserByUsername(c, c.Param("username"))
+	if !ok {
 		return
 	}
 
@@ -74,6 +69,11 @@ func FollowUser(c *gin.Context) {
 		return
 	}
 
+	if user.Visibility == db.VisibilityPrivate {
+		requestFollow(c, userId.(string), user)
+		return
+	}
+
 	follow := db.Follow{
 		FollowerId:  userId.(string),
 		FollowingId: user.Id,
@@ -84,12 +84,15 @@ func FollowUser(c *gin.Context) {
 		return
 	}
 
+	notify(user.Id, follow.FollowerId, db.NotificationFollow, nil)
+	recordFollowerMilestone(user.Id)
+
 	c.JSON(http.StatusCreated, follow)
 }
 
 // UnfollowUser godoc
 // @Summary Unfollow a user
-// @Description Unfollow a user by their username
+// @Description Unfollow a user by their username, or cancel a pending request to follow them
 // @Tags Follow
 // @Accept json
 // @Produce json
@@ -128,16 +131,34 @@ func UnfollowUser(c *gin.Context) {
 	}
 
 	if deleted.RowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Not following this user"})
+		var request db.FollowRequest
+		err := db.GetDB().Where("requester_id = ? AND target_id = ?", userId, user.Id).First(&request).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Not following this user"})
+			return
+		}
+		if err == nil {
+			err = db.GetDB().Delete(&request).Error
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to cancel follow request"})
+			return
+		}
+
+		withdrawEntityNotifications(request.Id)
+
+		c.JSON(http.StatusOK, gin.H{"message": "Follow request cancelled successfully"})
 		return
 	}
 
+	withdrawNotification(user.Id, userId.(string), db.NotificationFollow, nil)
+
 	c.JSON(http.StatusOK, gin.H{"message": "User unfollowed successfully"})
 }
 
 // GetFollowers godoc
 // @Summary Get followers
-// @Description Returns a paginated list of users who follow the specified user
+// @Description Returns a paginated list of users who follow the specified user. If their profile is private, only their followers can see the list, and private profiles in the list only show their name, image and headline to viewers who do not follow them. When the request is authenticated, each user is marked with whether they follow the viewer and whether the viewer follows them.
 // @Tags Follow
 // @Accept json
 // @Produce json
@@ -145,20 +166,13 @@ func UnfollowUser(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
 // @Success 200 {object} FollowResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /users/{username}/followers [get]
 func GetFollowers(c *gin.Context) {
-	username := c.Param("username")
-	var user db.User
-	result := db.GetDB().Where("username = ?", username).First(&user)
-
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+	user, ok := findVisibleProfile(c, c.Param("username"))
+	if !ok {
 		return
 	}
 
@@ -173,10 +187,10 @@ func GetFollowers(c *gin.Context) {
 	offset := (page - 1) * limit
 
 	var total int64
-	db.GetDB().Model(&db.Follow{}).Where("following_id = ?", user.Id).Count(&total)
+	hideBlocked(c, db.GetDB().Model(&db.Follow{}).Where("following_id = ?", user.Id), "follower_id").Count(&total)
 
 	var follows []db.Follow
-	if err := db.GetDB().Where("following_id = ?", user.Id).Preload("Follower").
+	if err := hideBlocked(c, db.GetDB().Where("following_id = ?", user.Id), "follower_id").Preload("Follower").
 		Offset(offset).Limit(limit).Find(&follows).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch followers"})
 		return
@@ -187,6 +201,17 @@ func GetFollowers(c *gin.Context) {
 		users = append(users, f.Follower)
 	}
 
+	if viewerId, ok := c.Get("userId"); ok {
+		if err := annotateFollowState(viewerId.(string), users); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch followers"})
+			return
+		}
+	}
+	if err := redactPrivateUsers(c, users); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch followers"})
+		return
+	}
+
 	c.JSON(http.StatusOK, FollowResponse{
 		Users: users,
 		Total: total,
@@ -197,7 +222,7 @@ func GetFollowers(c *gin.Context) {
 
 // GetFollowing godoc
 // @Summary Get following
-// @Description Returns a paginated list of users that the specified user follows
+// @Description Returns a paginated list of users that the specified user follows. If their profile is private, only their followers can see the list, and private profiles in the list only show their name, image and headline to viewers who do not follow them. When the request is authenticated, each user is marked with whether they follow the viewer and whether the viewer follows them.
 // @Tags Follow
 // @Accept json
 // @Produce json
@@ -205,20 +230,13 @@ func GetFollowers(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
 // @Success 200 {object} FollowResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /users/{username}/following [get]
 func GetFollowing(c *gin.Context) {
-	username := c.Param("username")
-	var user db.User
-	result := db.GetDB().Where("username = ?", username).First(&user)
-
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+	user, ok := findVisibleProfile(c, c.Param("username"))
+	if !ok {
 		return
 	}
 
@@ -233,10 +251,10 @@ func GetFollowing(c *gin.Context) {
 	offset := (page - 1) * limit
 
 	var total int64
-	db.GetDB().Model(&db.Follow{}).Where("follower_id = ?", user.Id).Count(&total)
+	hideBlocked(c, db.GetDB().Model(&db.Follow{}).Where("follower_id = ?", user.Id), "following_id").Count(&total)
 
 	var follows []db.Follow
-	if err := db.GetDB().Where("follower_id = ?", user.Id).Preload("Following").
+	if err := hideBlocked(c, db.GetDB().Where("follower_id = ?", user.Id), "following_id").Preload("Following").
 		Offset(offset).Limit(limit).Find(&follows).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch following"})
 		return
@@ -247,6 +265,17 @@ func GetFollowing(c *gin.Context) {
 		users = append(users, f.Following)
 	}
 
+	if viewerId, ok := c.Get("userId"); ok {
+		if err := annotateFollowState(viewerId.(string), users); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch following"})
+			return
+		}
+	}
+	if err := redactPrivateUsers(c, users); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch following"})
+		return
+	}
+
 	c.JSON(http.StatusOK, FollowResponse{
 		Users: users,
 		Total: total,
@@ -257,7 +286,7 @@ func GetFollowing(c *gin.Context) {
 
 // CheckFollowStatus godoc
 // @Summary Check follow status
-// @Description Check if the authenticated user follows a given user
+// @Description Check if the authenticated user follows a given user or has asked to, and if that user follows them back
 // @Tags Follow
 // @Accept json
 // @Produce json
@@ -275,30 +304,66 @@ func CheckFollowStatus(c *gin.Context) {
 		return
 	}
 
-	username := c.Param("username")
-	var user db.User
-	result := db.GetDB().Where("username = ?", username).First(&user)
+	user, ok := findUserByUsername(c, c.Param("username"))
+	if !ok {
+		return
+	}
 
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+	var followerIds []string
+	if err := db.GetDB().Model(&db.Follow{}).
+		Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)",
+			userId, user.Id, user.Id, userId).
+		Pluck("follower_id", &followerIds).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch following status"})
 		return
 	}
 
-	var follow db.Follow
-	err := db.GetDB().Where("follower_id = ? AND following_id = ?", userId, user.Id).First(&follow).Error
+	status := FollowStatusResponse{}
+	for _, followerId := range followerIds {
+		if followerId == userId.(string) {
+			status.IsFollowing = true
+		} else {
+			status.FollowsYou = true
+		}
+	}
 
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.JSON(http.StatusOK, gin.H{"isFollowing": false})
+	if !status.IsFollowing {
+		var requests int64
+		if err := db.GetDB().Model(&db.FollowRequest{}).Where("requester_id = ? AND target_id = ?", userId, user.Id).
+			Count(&requests).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch following status"})
 			return
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch following status"})
+		status.IsRequested = requests > 0
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// requestFollow asks to follow a private profile, writing the pending
+// request or a 409 if one was already sent
+func requestFollow(c *gin.Context, requesterId string, user *db.User) {
+	var existing db.FollowRequest
+	err := db.GetDB().Where("requester_id = ? AND target_id = ?", requesterId, user.Id).First(&existing).Error
+	if err == nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "Follow request already sent"})
+		return
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check follow request"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"isFollowing": true})
+	request := db.FollowRequest{
+		RequesterId: requesterId,
+		TargetId:    user.Id,
+	}
+	if err := db.GetDB().Create(&request).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request to follow user"})
+		return
+	}
+
+	notify(user.Id, requesterId, db.NotificationFollowRequest, &request.Id)
+
+	c.JSON(http.StatusAccepted, request)
 }
@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+// GetMutualFollows godoc
+// @Summary Get mutual follows
+// @Description Returns a paginated list of users who follow the specified user and are followed back by them. When the request is authenticated, each user is marked with whether they follow the viewer and whether the viewer follows them.
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the user"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} FollowResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{username}/mutuals [get]
+func GetMutualFollows(c *gin.Context) {
+	user, ok := findVisibleProfile(c, c.Param("username"))
+	if !ok {
+		return
+	}
+
+	listFollowGraph(c, func() *gorm.DB {
+		return db.GetDB().Model(&db.User{}).
+			Where("id IN (?)", followerIdsOf(user.Id)).
+			Where("id IN (?)", followingIdsOf(user.Id))
+	})
+}
+
+// GetKnownFollowers godoc
+// @Summary Get followers you know
+// @Description Returns a paginated list of the specified user's followers whom the authenticated user follows, each marked with whether they follow the viewer
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the user"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} FollowResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/{username}/known-followers [get]
+func GetKnownFollowers(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	user, ok := findVisibleProfile(c, c.Param("username"))
+	if !ok {
+		return
+	}
+
+	listFollowGraph(c, func() *gorm.DB {
+		return db.GetDB().Model(&db.User{}).
+			Where("id IN (?)", followerIdsOf(user.Id)).
+			Where("id IN (?)", followingIdsOf(userId.(string)))
+	})
+}
+
+// GetCommonConnections godoc
+// @Summary Get common connections
+// @Description Returns a paginated list of users followed by both specified users. When the request is authenticated, each user is marked with whether they follow the viewer and whether the viewer follows them.
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the first user"
+// @Param other path string true "Username of the second user"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} FollowResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{username}/common-connections/{other} [get]
+func GetCommonConnections(c *gin.Context) {
+	user, ok := findVisibleProfile(c, c.Param("username"))
+	if !ok {
+		return
+	}
+	other, ok := findVisibleProfile(c, c.Param("other"))
+	if !ok {
+		return
+	}
+
+	listFollowGraph(c, func() *gorm.DB {
+		return db.GetDB().Model(&db.User{}).
+			Where("id IN (?)", followingIdsOf(user.Id)).
+			Where("id IN (?)", followingIdsOf(other.Id))
+	})
+}
+
+// listFollowGraph writes a page of the users matched by query, ordered by
+// username. When there is a viewer, users they have blocked or been blocked
+// by are left out and the rest are annotated. Private profiles the viewer
+// does not follow are redacted. query is called once for the
+// count and once for the page.
+func listFollowGraph(c *gin.Context, query func() *gorm.DB) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	var total int64
+	hideBlocked(c, query(), "id").Count(&total)
+
+	users := []db.User{}
+	if err := hideBlocked(c, query(), "id").Order("username ASC").Offset(offset).Limit(limit).Find(&users).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch users"})
+		return
+	}
+
+	if viewerId, ok := c.Get("userId"); ok {
+		if err := annotateFollowState(viewerId.(string), users); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch users"})
+			return
+		}
+	}
+	if err := redactPrivateUsers(c, users); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch users"})
+		return
+	}
+
+	c.JSON(http.StatusOK, FollowResponse{
+		Users: users,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	})
+}
+
+// annotateFollowState marks each user with whether they follow the viewer
+// and whether the viewer follows them, using one query per direction. The
+// viewer's own entry is left unmarked.
+func annotateFollowState(viewerId string, users []db.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+
+	var followerIds []string
+	if err := db.GetDB().Model(&db.Follow{}).Where("following_id = ? AND follower_id IN ?", viewerId, ids).
+		Pluck("follower_id", &followerIds).Error; err != nil {
+		return err
+	}
+	var followingIds []string
+	if err := db.GetDB().Model(&db.Follow{}).Where("follower_id = ? AND following_id IN ?", viewerId, ids).
+		Pluck("following_id", &followingIds).Error; err != nil {
+		return err
+	}
+
+	followsYou := make(map[string]bool, len(followerIds))
+	for _, id := range followerIds {
+		followsYou[id] = true
+	}
+	youFollow := make(map[string]bool, len(followingIds))
+	for _, id := range followingIds {
+		youFollow[id] = true
+	}
+
+	for i := range users {
+		if users[i].Id == viewerId {
+			continue
+		}
+		follows, followed := followsYou[users[i].Id], youFollow[users[i].Id]
+		users[i].FollowsYou = &follows
+		users[i].YouFollow = &followed
+	}
+
+	return nil
+}
+
+// followerIdsOf selects the ids of the users who follow userId
+func followerIdsOf(userId string) *gorm.DB {
+	return db.GetDB().Model(&db.Follow{}).Select("follower_id").Where("following_id = ?", userId)
+}
+
+// followingIdsOf selects the ids of the users userId follows
+func followingIdsOf(userId string) *gorm.DB {
+	return db.GetDB().Model(&db.Follow{}).Select("following_id").Where("follower_id = ?", userId)
+}
+
+// findUserByUsername loads the user with the given username, writing a 404
+// or 500 response if it cannot. A user who has blocked the viewer, or whom
+// the viewer has blocked, is not found.
+func findUserByUsername(c *gin.Context, username string) (*db.User, bool) {
+	var user db.User
+	result := db.GetDB().Where("username = ?", username).First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return nil, false
+	}
+
+	hidden, err := hiddenByBlock(c, user.Id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return nil, false
+	}
+	if hidden {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return nil, false
+	}
+
+	return &user, true
+}
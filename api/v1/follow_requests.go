@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FollowRequestsResponse struct {
+	Requests []db.FollowRequest `json:"requests"`
+	Total    int64              `json:"total"`
+	Page     int                `json:"page"`
+	Limit    int                `json:"limit"`
+}
+
+// GetFollowRequests godoc
+// @Summary Get follow requests
+// @Description Returns a paginated, oldest-first list of pending requests to follow the authenticated user
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} FollowRequestsResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/follow-requests [get]
+func GetFollowRequests(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	var total int64
+	db.GetDB().Model(&db.FollowRequest{}).Where("target_id = ?", userId).Count(&total)
+
+	requests := []db.FollowRequest{}
+	if err := db.GetDB().Where("target_id = ?", userId).Preload("Requester").
+		Order("created_at ASC").Offset(offset).Limit(limit).Find(&requests).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch follow requests"})
+		return
+	}
+
+	c.JSON(http.StatusOK, FollowRequestsResponse{
+		Requests: requests,
+		Total:    total,
+		Page:     page,
+		Limit:    limit,
+	})
+}
+
+// ApproveFollowRequest godoc
+// @Summary Approve a follow request
+// @Description Approves a pending request to follow the authenticated user, making the requester a follower
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "Follow request ID"
+// @Success 201 {object} db.Follow
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/follow-requests/{id}/approve [post]
+func ApproveFollowRequest(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	request, ok := findFollowRequest(c, userId.(string))
+	if !ok {
+		return
+	}
+
+	follow := db.Follow{
+		FollowerId:  request.RequesterId,
+		FollowingId: request.TargetId,
+	}
+
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&follow).Error; err != nil {
+			return err
+		}
+		return tx.Delete(request).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to approve follow request"})
+		return
+	}
+
+	withdrawEntityNotifications(request.Id)
+	notify(request.RequesterId, request.TargetId, db.NotificationFollowAccepted, nil)
+	recordFollowerMilestone(request.TargetId)
+
+	c.JSON(http.StatusCreated, follow)
+}
+
+// DenyFollowRequest godoc
+// @Summary Deny a follow request
+// @Description Denies a pending request to follow the authenticated user. The requester is not told and may ask again.
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "Follow request ID"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/follow-requests/{id}/deny [post]
+func DenyFollowRequest(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	request, ok := findFollowRequest(c, userId.(string))
+	if !ok {
+		return
+	}
+
+	if err := db.GetDB().Delete(request).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to deny follow request"})
+		return
+	}
+
+	withdrawEntityNotifications(request.Id)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Follow request denied successfully"})
+}
+
+// findFollowRequest loads the pending request named by the id path parameter
+// to follow targetId, writing a 404 or 500 response if it cannot
+func findFollowRequest(c *gin.Context, targetId string) (*db.FollowRequest, bool) {
+	var request db.FollowRequest
+	result := db.GetDB().Where("id = ? AND target_id = ?", c.Param("id"), targetId).First(&request)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Follow request not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch follow request"})
+		return nil, false
+	}
+	return &request, true
+}
+
+// acceptAllFollowRequests turns every pending request to follow userId into
+// a follow, for when their profile is made public
+func acceptAllFollowRequests(tx *gorm.DB, userId string) error {
+	if err := tx.Exec(`
+		INSERT INTO follows (follower_id, following_id, created_at)
+		SELECT requester_id, target_id, NOW() FROM follow_requests WHERE target_id = ?
+		ON CONFLICT DO NOTHING`, userId).Error; err != nil {
+		return err
+	}
+	return tx.Where("target_id = ?", userId).Delete(&db.FollowRequest{}).Error
+}
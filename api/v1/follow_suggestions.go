@@ -0,0 +1,316 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+// suggestionCandidateLimit caps how many candidates each signal contributes,
+// which keeps the ranking cheap for well-connected users
+const suggestionCandidateLimit = 500
+
+// Weights of each signal when ranking follow suggestions. A mutual
+// connection or shared workplace says more than a single shared skill.
+const (
+	suggestionSkillWeight      = 1
+	suggestionCompanyWeight    = 3
+	suggestionUniversityWeight = 2
+	suggestionMutualWeight     = 2
+
+	// Skills and mutual connections stop adding to the score past these
+	// counts so one signal cannot drown out the others
+	suggestionMaxSkills  = 5
+	suggestionMaxMutuals = 5
+)
+
+// FollowSuggestion is a user the authenticated user might want to follow
+type FollowSuggestion struct {
+	User    db.User  `json:"user"`
+	Score   int      `json:"score" example:"7"`
+	Reasons []string `json:"reasons" example:"Followed by janedoe and 2 others you follow,Also worked at Stripe"`
+}
+
+// suggestionCandidate collects the signals found for one candidate
+type suggestionCandidate struct {
+	skills       []string
+	companies    []string
+	universities []string
+	mutuals      []string
+	mutualCount  int
+}
+
+// GetFollowSuggestions godoc
+// @Summary Get who to follow
+// @Description Suggests users to follow, ranked by people you follow who follow them, shared companies and universities, and overlapping skills. Users you already follow or have blocked are excluded. Each suggestion explains why it was made.
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param limit query int false "Number of suggestions" default(10)
+// @Success 200 {array} FollowSuggestion
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/recommendations [get]
+func GetFollowSuggestions(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	var user db.User
+	if err := db.GetDB().Preload("Education").Preload("Experience").
+		Where("id = ?", userId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+
+	candidates, err := findSuggestionCandidates(&user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to find suggestions"})
+		return
+	}
+
+	ids := make([]string, 0, len(candidates))
+	for id := range candidates {
+		ids = append(ids, id)
+	}
+	var users []db.User
+	if len(ids) > 0 {
+		if err := hideBlocked(c, db.GetDB().Where("id IN ?", ids), "id").Find(&users).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch suggestions"})
+			return
+		}
+		if err := redactPrivateUsers(c, users); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch suggestions"})
+			return
+		}
+	}
+
+	suggestions := make([]FollowSuggestion, 0, len(users))
+	for _, candidate := range users {
+		score, reasons := scoreSuggestion(candidates[candidate.Id])
+		suggestions = append(suggestions, FollowSuggestion{
+			User:    candidate,
+			Score:   score,
+			Reasons: reasons,
+		})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.User.CreatedAt.After(b.User.CreatedAt)
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	c.JSON(http.StatusOK, suggestions)
+}
+
+// findSuggestionCandidates gathers users who share a signal with the user,
+// leaving out the user and everyone they already follow. Private profiles
+// are only suggested through the people the user follows, since their
+// experience, education and skills are hidden.
+func findSuggestionCandidates(user *db.User) (map[string]*suggestionCandidate, error) {
+	following := db.GetDB().Model(&db.Follow{}).Select("following_id").Where("follower_id = ?", user.Id)
+	public := db.GetDB().Model(&db.User{}).Select("id").Where("visibility <> ?", db.VisibilityPrivate)
+
+	candidates := make(map[string]*suggestionCandidate)
+	candidate := func(id string) *suggestionCandidate {
+		if candidates[id] == nil {
+			candidates[id] = &suggestionCandidate{}
+		}
+		return candidates[id]
+	}
+
+	// Friends of friends: people followed by the people the user follows
+	var mutuals []struct {
+		FollowingId string
+		Username    string
+	}
+	if err := db.GetDB().Table("follows AS f").
+		Select("f.following_id, u.username").
+		Joins("JOIN users u ON u.id = f.follower_id").
+		Where("f.follower_id IN (?)", following).
+		Where("f.following_id <> ? AND f.following_id NOT IN (?)", user.Id, following).
+		Order("f.created_at DESC").Limit(suggestionCandidateLimit).
+		Scan(&mutuals).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range mutuals {
+		entry := candidate(row.FollowingId)
+		entry.mutualCount++
+		entry.mutuals = append(entry.mutuals, row.Username)
+	}
+
+	// Shared companies
+	var companies []string
+	for _, exp := range user.Experience {
+		companies = append(companies, exp.Company)
+	}
+	companies = lowerUnique(companies)
+	if len(companies) > 0 {
+		var rows []struct {
+			UserId  string
+			Company string
+		}
+		if err := db.GetDB().Model(&db.Experience{}).Select("DISTINCT user_id, company").
+			Where("LOWER(company) IN ?", companies).
+			Where("user_id <> ? AND user_id NOT IN (?) AND user_id IN (?)", user.Id, following, public).
+			Limit(suggestionCandidateLimit).Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			entry := candidate(row.UserId)
+			entry.companies = appendFold(entry.companies, row.Company)
+		}
+	}
+
+	// Shared universities
+	var universities []string
+	for _, edu := range user.Education {
+		universities = append(universities, edu.UniversityName)
+	}
+	universities = lowerUnique(universities)
+	if len(universities) > 0 {
+		var rows []struct {
+			UserId         string
+			UniversityName string
+		}
+		if err := db.GetDB().Model(&db.Education{}).Select("DISTINCT user_id, university_name").
+			Where("LOWER(university_name) IN ?", universities).
+			Where("user_id <> ? AND user_id NOT IN (?) AND user_id IN (?)", user.Id, following, public).
+			Limit(suggestionCandidateLimit).Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			entry := candidate(row.UserId)
+			entry.universities = appendFold(entry.universities, row.UniversityName)
+		}
+	}
+
+	// Overlapping skills
+	skills := lowerUnique(user.Skills)
+	if len(skills) > 0 {
+		var rows []struct {
+			Id     string
+			Skills pq.StringArray `gorm:"type:text[]"`
+		}
+		if err := db.GetDB().Model(&db.User{}).Select("id, skills").
+			Where("EXISTS (SELECT 1 FROM unnest(skills) AS skill WHERE LOWER(skill) = ANY(?))", pq.StringArray(skills)).
+			Where("id <> ? AND id NOT IN (?) AND visibility <> ?", user.Id, following, db.VisibilityPrivate).
+			Limit(suggestionCandidateLimit).Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+		wanted := make(map[string]bool, len(skills))
+		for _, skill := range skills {
+			wanted[skill] = true
+		}
+		for _, row := range rows {
+			entry := candidate(row.Id)
+			for _, skill := range row.Skills {
+				if wanted[strings.ToLower(skill)] {
+					entry.skills = appendFold(entry.skills, skill)
+				}
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+// scoreSuggestion weighs a candidate's signals and explains them, strongest
+// signal first
+func scoreSuggestion(candidate *suggestionCandidate) (int, []string) {
+	score := 0
+	reasons := []string{}
+
+	if candidate.mutualCount > 0 {
+		score += suggestionMutualWeight * min(candidate.mutualCount, suggestionMaxMutuals)
+		if candidate.mutualCount == 1 {
+			reasons = append(reasons, fmt.Sprintf("Followed by %s, who you follow", candidate.mutuals[0]))
+		} else {
+			reasons = append(reasons, fmt.Sprintf("Followed by %s and %d others you follow",
+				candidate.mutuals[0], candidate.mutualCount-1))
+		}
+	}
+
+	if len(candidate.companies) > 0 {
+		score += suggestionCompanyWeight * len(candidate.companies)
+		reasons = append(reasons, "Also worked at "+joinNames(candidate.companies))
+	}
+
+	if len(candidate.universities) > 0 {
+		score += suggestionUniversityWeight * len(candidate.universities)
+		reasons = append(reasons, "Also studied at "+joinNames(candidate.universities))
+	}
+
+	if len(candidate.skills) > 0 {
+		score += suggestionSkillWeight * min(len(candidate.skills), suggestionMaxSkills)
+		reasons = append(reasons, "Also knows "+joinNames(candidate.skills))
+	}
+
+	return score, reasons
+}
+
+// joinNames lists up to three names in prose, summarising the rest
+func joinNames(names []string) string {
+	switch {
+	case len(names) == 1:
+		return names[0]
+	case len(names) == 2:
+		return names[0] + " and " + names[1]
+	case len(names) == 3:
+		return names[0] + ", " + names[1] + " and " + names[2]
+	default:
+		return fmt.Sprintf("%s, %s and %d more", names[0], names[1], len(names)-2)
+	}
+}
+
+// lowerUnique collects lower-cased, trimmed, non-empty values without
+// duplicates
+func lowerUnique(values []string) []string {
+	seen := make(map[string]bool)
+	unique := []string{}
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value != "" && !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// appendFold appends value unless it is already present ignoring case
+func appendFold(values []string, value string) []string {
+	for _, existing := range values {
+		if strings.EqualFold(existing, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
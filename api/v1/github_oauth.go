@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/services"
+	"gorm.io/gorm"
+)
+
+// GitHubAuthorizeResponse represents the GitHub page the user is sent to
+type GitHubAuthorizeResponse struct {
+	AuthorizeURL string `json:"authorizeUrl" example:"https://github.com/login/oauth/authorize?client_id=Iv1.abc&scope=read%3Auser&state=..."`
+}
+
+// GitHubCallbackRequest represents the parameters GitHub redirects back with
+type GitHubCallbackRequest struct {
+	Code  string `json:"code" binding:"required" example:"e2c1f4a9b7d3"`
+	State string `json:"state" binding:"required"`
+}
+
+// StartGitHubOAuth godoc
+// @Summary Start GitHub account linking
+// @Description Returns the GitHub authorization URL for linking the user's GitHub account. Linking lets contribution counts include private contributions. The state in the URL is bound to the user and expires after ten minutes.
+// @Tags GitHub
+// @Accept json
+// @Produce json
+// @Success 200 {object} GitHubAuthorizeResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/github/oauth [post]
+func StartGitHubOAuth(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	oauth, err := services.NewGitHubOAuth()
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	authorizeURL, err := oauth.AuthorizeURL(userId.(string))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start GitHub authorization"})
+		return
+	}
+
+	c.JSON(http.StatusOK, GitHubAuthorizeResponse{AuthorizeURL: authorizeURL})
+}
+
+// CompleteGitHubOAuth godoc
+// @Summary Complete GitHub account linking
+// @Description Exchanges the code GitHub redirected back with for a token, stores it encrypted and sets the user's GitHub username to the authorized account, which also marks it as verified. Linking again replaces the stored token.
+// @Tags GitHub
+// @Accept json
+// @Produce json
+// @Param request body GitHubCallbackRequest true "Code and state from the GitHub redirect"
+// @Success 200 {object} ConnectedAccountResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/github/oauth/callback [post]
+func CompleteGitHubOAuth(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req GitHubCallbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	oauth, err := services.NewGitHubOAuth()
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	if err := oauth.VerifyState(req.State, userId.(string)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired authorization state, start linking again"})
+		return
+	}
+
+	token, err := oauth.Exchange(c.Request.Context(), req.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidOAuthGrant) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired authorization code"})
+			return
+		}
+		respondExternalError(c, err)
+		return
+	}
+
+	login, err := services.NewGitHubServiceWithToken(token.AccessToken).GetViewerLogin(c.Request.Context())
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	var account db.ConnectedAccount
+	result := db.GetDB().Where("user_id = ? AND provider = ?", userId, db.ConnectedAccountGitHub).First(&account)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch connected account"})
+		return
+	}
+
+	account.UserId = userId.(string)
+	account.Provider = db.ConnectedAccountGitHub
+	account.AccountName = login
+	if err := services.SetGitHubToken(&account, token); err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	// Authorizing the app proves ownership, so the account counts as
+	// verified and any pending verification challenge is dropped
+	err = db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&account).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&db.User{}).Where("id = ?", userId).Updates(map[string]interface{}{
+			"git_hub_username": login,
+			"git_hub_verified": true,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ? AND provider = ?", userId, db.VerificationProviderGitHub).
+			Delete(&db.AccountVerification{}).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save connected account"})
+		return
+	}
+
+	c.JSON(http.StatusOK, ConnectedAccountResponse{
+		Provider:    account.Provider,
+		AccountName: account.AccountName,
+		ConnectedAt: account.UpdatedAt,
+	})
+}
+
+// DisconnectGitHubOAuth godoc
+// @Summary Unlink GitHub account
+// @Description Revokes the app's GitHub authorization and removes the stored token. The GitHub username stays on the profile, and contribution data is fetched with the shared token again.
+// @Tags GitHub
+// @Accept json
+// @Produce json
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/github/oauth [delete]
+func DisconnectGitHubOAuth(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var account db.ConnectedAccount
+	result := db.GetDB().Where("user_id = ? AND provider = ?", userId, db.ConnectedAccountGitHub).First(&account)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No GitHub account is connected"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch connected account"})
+		return
+	}
+
+	// The stored token is removed even if revoking fails, since the user can
+	// still revoke the app from their GitHub settings
+	if err := revokeGitHubToken(c, &account); err != nil {
+		log.Printf("Failed to revoke GitHub token for user %s: %v", account.UserId, err)
+	}
+
+	if err := db.GetDB().Delete(&account).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to disconnect GitHub account"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "GitHub account disconnected"})
+}
+
+// revokeGitHubToken revokes a stored token at GitHub
+func revokeGitHubToken(c *gin.Context, account *db.ConnectedAccount) error {
+	oauth, err := services.NewGitHubOAuth()
+	if err != nil {
+		return err
+	}
+
+	token, err := services.DecryptSecret(account.EncryptedToken)
+	if err != nil {
+		return err
+	}
+
+	return oauth.Revoke(c.Request.Context(), token)
+}
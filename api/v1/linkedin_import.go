@@ -0,0 +1,262 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/services"
+	"gorm.io/gorm"
+)
+
+// linkedinMaxUploadSize is the largest LinkedIn export accepted
+const linkedinMaxUploadSize = 10 << 20
+
+// LinkedInImportResponse describes the changes a LinkedIn import makes. The
+// same shape is returned for the preview and once the import is applied.
+type LinkedInImportResponse struct {
+	Applied    bool                  `json:"applied" example:"false"`
+	Profile    map[string]string     `json:"profile"`
+	Experience []db.Experience       `json:"experience"`
+	Education  []db.Education        `json:"education"`
+	Skills     []string              `json:"skills" example:"Go,PostgreSQL"`
+	Skipped    LinkedInImportSkipped `json:"skipped"`
+}
+
+// LinkedInImportSkipped counts export entries left out because they already
+// exist on the profile or are missing required fields
+type LinkedInImportSkipped struct {
+	Experience int `json:"experience" example:"2"`
+	Education  int `json:"education" example:"1"`
+	Skills     int `json:"skills" example:"5"`
+}
+
+// ImportLinkedIn godoc
+// @Summary Import LinkedIn data export
+// @Description Reads experience, education, skills and basic profile fields from the official LinkedIn data-export ZIP. Without confirm the changes are only previewed. Entries already on the profile are skipped, and profile fields are only filled in when empty.
+// @Tags Users
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "LinkedIn data export ZIP"
+// @Param confirm query bool false "Apply the import instead of previewing it"
+// @Success 200 {object} LinkedInImportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 413 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/import/linkedin [post]
+func ImportLinkedIn(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A LinkedIn export ZIP must be uploaded as file"})
+		return
+	}
+	if fileHeader.Size > linkedinMaxUploadSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "LinkedIn export must be 10 MB or smaller"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	export, err := services.ParseLinkedInExport(file, fileHeader.Size)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var user db.User
+	if err := db.GetDB().Preload("Education").Preload("Experience").
+		Where("id = ?", userId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+
+	plan := planLinkedInImport(&user, export)
+
+	if c.Query("confirm") != "true" {
+		c.JSON(http.StatusOK, plan)
+		return
+	}
+
+	err = db.GetDB().Transaction(func(tx *gorm.DB) error {
+		updates := make(map[string]interface{})
+		for field, value := range plan.Profile {
+			updates[linkedinProfileColumns[field]] = value
+		}
+		if len(plan.Skills) > 0 {
+			skills := append(pq.StringArray{}, user.Skills...)
+			updates["skills"] = append(skills, plan.Skills...)
+		}
+		if len(updates) > 0 {
+			if err := tx.Model(&user).Updates(updates).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(plan.Experience) > 0 {
+			if err := tx.Create(&plan.Experience).Error; err != nil {
+				return err
+			}
+		}
+		if len(plan.Education) > 0 {
+			if err := tx.Create(&plan.Education).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import LinkedIn data"})
+		return
+	}
+
+	// Announced as if added by hand: current positions and new skills only
+	for _, experience := range plan.Experience {
+		if experience.IsCurrent {
+			recordEvent(user.Id, db.EventNewRole, db.EventPayload{
+				"experienceId": experience.Id,
+				"company":      experience.Company,
+				"title":        experience.Title,
+			})
+		}
+	}
+	if len(plan.Skills) > 0 {
+		recordEvent(user.Id, db.EventSkillsUpdated, db.EventPayload{"added": plan.Skills})
+	}
+
+	plan.Applied = true
+	c.JSON(http.StatusOK, plan)
+}
+
+// linkedinProfileColumns maps the profile fields an import can fill to their
+// users columns
+var linkedinProfileColumns = map[string]string{
+	"firstName": "first_name",
+	"lastName":  "last_name",
+	"headline":  "headline",
+}
+
+// planLinkedInImport works out which parts of an export are new to the user
+func planLinkedInImport(user *db.User, export *services.LinkedInExport) *LinkedInImportResponse {
+	plan := &LinkedInImportResponse{
+		Profile:    make(map[string]string),
+		Experience: []db.Experience{},
+		Education:  []db.Education{},
+		Skills:     []string{},
+	}
+
+	fillIfEmpty := func(field string, current *string, value string) {
+		if value != "" && (current == nil || *current == "") {
+			plan.Profile[field] = value
+		}
+	}
+	fillIfEmpty("firstName", user.FirstName, export.Profile.FirstName)
+	fillIfEmpty("lastName", user.LastName, export.Profile.LastName)
+	fillIfEmpty("headline", user.Headline, export.Profile.Headline)
+
+	seen := make(map[string]bool)
+	for _, exp := range user.Experience {
+		seen[importKey(exp.Company, exp.Title, exp.StartYear)] = true
+	}
+	for _, position := range export.Positions {
+		key := importKey(position.Company, position.Title, position.StartYear)
+		if position.StartYear == "" || seen[key] {
+			plan.Skipped.Experience++
+			continue
+		}
+		seen[key] = true
+
+		experience := db.Experience{
+			UserId:      user.Id,
+			Company:     position.Company,
+			Title:       position.Title,
+			StartMonth:  position.StartMonth,
+			StartYear:   position.StartYear,
+			IsCurrent:   position.IsCurrent,
+			Location:    optionalString(position.Location),
+			Description: optionalString(position.Description),
+		}
+		if !position.IsCurrent {
+			experience.EndMonth = optionalString(position.EndMonth)
+			experience.EndYear = optionalString(position.EndYear)
+		}
+		plan.Experience = append(plan.Experience, experience)
+	}
+
+	seen = make(map[string]bool)
+	for _, edu := range user.Education {
+		seen[importKey(edu.UniversityName, edu.StartYear)] = true
+	}
+	for _, school := range export.Education {
+		key := importKey(school.School, school.StartYear)
+		if school.StartYear == "" || seen[key] {
+			plan.Skipped.Education++
+			continue
+		}
+		seen[key] = true
+
+		major := school.Field
+		if major == "" {
+			major = school.Degree
+		}
+		plan.Education = append(plan.Education, db.Education{
+			UserId:         user.Id,
+			UniversityName: school.School,
+			StartYear:      school.StartYear,
+			GraduationYear: school.EndYear,
+			Major:          major,
+		})
+	}
+
+	seen = make(map[string]bool)
+	for _, skill := range user.Skills {
+		seen[strings.ToLower(skill)] = true
+	}
+	for _, skill := range export.Skills {
+		if seen[strings.ToLower(skill)] {
+			plan.Skipped.Skills++
+			continue
+		}
+		seen[strings.ToLower(skill)] = true
+		plan.Skills = append(plan.Skills, skill)
+	}
+
+	return plan
+}
+
+// importKey builds a case-insensitive key for matching imported entries
+// against existing ones
+func importKey(parts ...string) string {
+	for i, part := range parts {
+		parts[i] = strings.ToLower(strings.TrimSpace(part))
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// optionalString returns nil for an empty string
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,522 @@
+package v1
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+// A user may start at most firstContactLimit conversations within any
+// firstContactWindow. Replies and messages in existing conversations are not
+// limited.
+const (
+	firstContactLimit  = 20
+	firstContactWindow = 24 * time.Hour
+)
+
+// StartConversationRequest represents the request body for messaging a user
+type StartConversationRequest struct {
+	Username string `json:"username" binding:"required" example:"janedoe"`
+	Body     string `json:"body" binding:"required,max=5000" example:"Hi Jane, loved your talk on distributed tracing!"`
+}
+
+// SendMessageRequest represents the request body for sending a message in a
+// conversation
+type SendMessageRequest struct {
+	Body string `json:"body" binding:"required,max=5000" example:"Thanks! Happy to chat more."`
+}
+
+type ConversationsResponse struct {
+	Conversations []db.Conversation `json:"conversations"`
+	Total         int64             `json:"total"`
+	Page          int               `json:"page"`
+	Limit         int               `json:"limit"`
+}
+
+type MessagesResponse struct {
+	Messages []db.Message `json:"messages"`
+	Total    int64        `json:"total"`
+	Page     int          `json:"page"`
+	Limit    int          `json:"limit"`
+}
+
+// GetConversations godoc
+// @Summary Get conversations
+// @Description Returns a paginated list of the authenticated user's conversations, most recently active first. Each includes its members with their read receipts, its latest message and how many messages the user has not read. Conversations with users the user has blocked or been blocked by are left out.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} ConversationsResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /conversations [get]
+func GetConversations(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	query := func() *gorm.DB {
+		return visibleConversations(db.GetDB().Model(&db.Conversation{}), userId.(string))
+	}
+
+	var total int64
+	query().Count(&total)
+
+	conversations := []db.Conversation{}
+	if err := query().Preload("Members.User").
+		Order("last_message_at DESC, id DESC").Offset(offset).Limit(limit).Find(&conversations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch conversations"})
+		return
+	}
+
+	if err := loadConversationSummaries(c, userId.(string), conversations); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch conversations"})
+		return
+	}
+
+	c.JSON(http.StatusOK, ConversationsResponse{
+		Conversations: conversations,
+		Total:         total,
+		Page:          page,
+		Limit:         limit,
+	})
+}
+
+// StartConversation godoc
+// @Summary Message a user
+// @Description Sends a message to a user, starting a conversation with them unless there already is one. Users choose whether anyone, only their followers, or only users they follow back may start a conversation with them, and each user may only start a limited number of conversations a day.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param request body StartConversationRequest true "Recipient and message"
+// @Success 201 {object} db.Message
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /conversations [post]
+func StartConversation(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req StartConversationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Message cannot be empty"})
+		return
+	}
+
+	recipient, ok := findUserByUsername(c, req.Username)
+	if !ok {
+		return
+	}
+	if recipient.Id == userId.(string) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot message yourself"})
+		return
+	}
+
+	var conversation db.Conversation
+	err := db.GetDB().Where("pair_key = ?", conversationPairKey(userId.(string), recipient.Id)).First(&conversation).Error
+	if err == nil {
+		sendMessage(c, &conversation, userId.(string), body)
+		return
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch conversation"})
+		return
+	}
+
+	allowed, err := canMessage(userId.(string), recipient)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check message settings"})
+		return
+	}
+	if !allowed {
+		c.JSON(http.StatusForbidden, gin.H{"error": "This user does not accept messages from you"})
+		return
+	}
+
+	now := time.Now()
+	var started []time.Time
+	if err := db.GetDB().Model(&db.Conversation{}).
+		Where("started_by = ? AND created_at > ?", userId, now.Add(-firstContactWindow)).
+		Order("created_at ASC").Pluck("created_at", &started).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start conversation"})
+		return
+	}
+	if len(started) >= firstContactLimit {
+		// A slot frees up once the conversation that used it leaves the window
+		retry := started[len(started)-firstContactLimit].Add(firstContactWindow).Sub(now)
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retry.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many new conversations, try again later"})
+		return
+	}
+
+	conversation = db.Conversation{
+		PairKey:       conversationPairKey(userId.(string), recipient.Id),
+		StartedBy:     userId.(string),
+		LastMessageAt: now,
+	}
+	message := db.Message{
+		SenderId: userId.(string),
+		Body:     body,
+	}
+
+	err = db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&conversation).Error; err != nil {
+			return err
+		}
+		members := []db.ConversationMember{
+			{ConversationId: conversation.Id, UserId: userId.(string), LastReadAt: &now},
+			{ConversationId: conversation.Id, UserId: recipient.Id},
+		}
+		if err := tx.Create(&members).Error; err != nil {
+			return err
+		}
+		message.ConversationId = conversation.Id
+		return tx.Create(&message).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start conversation"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, message)
+}
+
+// GetMessages godoc
+// @Summary Get messages
+// @Description Returns a paginated, newest-first list of the messages in one of the authenticated user's conversations
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "Conversation ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(50)
+// @Success 200 {object} MessagesResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /conversations/{id}/messages [get]
+func GetMessages(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	conversation, ok := findConversation(c, userId.(string))
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 50
+	}
+	offset := (page - 1) * limit
+
+	var total int64
+	db.GetDB().Model(&db.Message{}).Where("conversation_id = ?", conversation.Id).Count(&total)
+
+	messages := []db.Message{}
+	if err := db.GetDB().Where("conversation_id = ?", conversation.Id).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&messages).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch messages"})
+		return
+	}
+
+	c.JSON(http.StatusOK, MessagesResponse{
+		Messages: messages,
+		Total:    total,
+		Page:     page,
+		Limit:    limit,
+	})
+}
+
+// SendMessage godoc
+// @Summary Send a message
+// @Description Sends a message in one of the authenticated user's conversations
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "Conversation ID"
+// @Param request body SendMessageRequest true "Message"
+// @Success 201 {object} db.Message
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /conversations/{id}/messages [post]
+func SendMessage(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req SendMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Message cannot be empty"})
+		return
+	}
+
+	conversation, ok := findConversation(c, userId.(string))
+	if !ok {
+		return
+	}
+
+	sendMessage(c, conversation, userId.(string), body)
+}
+
+// MarkConversationRead godoc
+// @Summary Mark a conversation as read
+// @Description Marks every message in one of the authenticated user's conversations as read, updating their read receipt
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "Conversation ID"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /conversations/{id}/read [post]
+func MarkConversationRead(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	conversation, ok := findConversation(c, userId.(string))
+	if !ok {
+		return
+	}
+
+	if err := db.GetDB().Model(&db.ConversationMember{}).
+		Where("conversation_id = ? AND user_id = ?", conversation.Id, userId).
+		Update("last_read_at", time.Now()).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark conversation as read"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Conversation marked as read"})
+}
+
+// sendMessage adds a message from senderId to an existing conversation,
+// writing the message or a 403 if the members have since blocked each other
+func sendMessage(c *gin.Context, conversation *db.Conversation, senderId, body string) {
+	var recipientIds []string
+	if err := db.GetDB().Model(&db.ConversationMember{}).
+		Where("conversation_id = ? AND user_id <> ?", conversation.Id, senderId).
+		Pluck("user_id", &recipientIds).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send message"})
+		return
+	}
+	for _, recipientId := range recipientIds {
+		blocked, err := isBlocked(senderId, recipientId)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send message"})
+			return
+		}
+		if blocked {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Cannot message this user"})
+			return
+		}
+	}
+
+	now := time.Now()
+	message := db.Message{
+		ConversationId: conversation.Id,
+		SenderId:       senderId,
+		Body:           body,
+	}
+
+	// Sending a message also means the sender has read everything before it
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&message).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(conversation).Update("last_message_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Model(&db.ConversationMember{}).Where("conversation_id = ? AND user_id = ?", conversation.Id, senderId).
+			Update("last_read_at", now).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send message"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, message)
+}
+
+// canMessage reports whether senderId may start a conversation with the
+// recipient under the recipient's message settings
+func canMessage(senderId string, recipient *db.User) (bool, error) {
+	switch recipient.AllowMessages {
+	case db.AllowMessagesFollowers:
+		var count int64
+		err := db.GetDB().Model(&db.Follow{}).Where("follower_id = ? AND following_id = ?", senderId, recipient.Id).
+			Count(&count).Error
+		return count > 0, err
+	case db.AllowMessagesMutuals:
+		var count int64
+		err := db.GetDB().Model(&db.Follow{}).
+			Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)",
+				senderId, recipient.Id, recipient.Id, senderId).
+			Count(&count).Error
+		return count == 2, err
+	default:
+		return true, nil
+	}
+}
+
+// loadConversationSummaries fills in the latest message and the user's
+// unread count of each conversation, with one query for each, and redacts
+// members with private profiles in a single further query
+func loadConversationSummaries(c *gin.Context, userId string, conversations []db.Conversation) error {
+	if len(conversations) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(conversations))
+	for _, conversation := range conversations {
+		ids = append(ids, conversation.Id)
+	}
+
+	var latest []db.Message
+	if err := db.GetDB().Raw(`
+		SELECT DISTINCT ON (conversation_id) * FROM messages
+		WHERE conversation_id IN ?
+		ORDER BY conversation_id, created_at DESC, id DESC`, ids).Scan(&latest).Error; err != nil {
+		return err
+	}
+
+	var unread []struct {
+		ConversationId string
+		Count          int64
+	}
+	if err := db.GetDB().Table("messages AS m").Select("m.conversation_id, COUNT(*) AS count").
+		Joins("JOIN conversation_members cm ON cm.conversation_id = m.conversation_id AND cm.user_id = ?", userId).
+		Where("m.conversation_id IN ? AND m.sender_id <> ?", ids, userId).
+		Where("cm.last_read_at IS NULL OR m.created_at > cm.last_read_at").
+		Group("m.conversation_id").Scan(&unread).Error; err != nil {
+		return err
+	}
+
+	latestByConversation := make(map[string]db.Message, len(latest))
+	for _, message := range latest {
+		latestByConversation[message.ConversationId] = message
+	}
+	unreadByConversation := make(map[string]int64, len(unread))
+	for _, row := range unread {
+		unreadByConversation[row.ConversationId] = row.Count
+	}
+
+	for i := range conversations {
+		if message, ok := latestByConversation[conversations[i].Id]; ok {
+			conversations[i].LastMessage = &message
+		}
+		conversations[i].UnreadCount = unreadByConversation[conversations[i].Id]
+	}
+
+	users := []db.User{}
+	for _, conversation := range conversations {
+		for _, member := range conversation.Members {
+			users = append(users, member.User)
+		}
+	}
+	if err := redactPrivateUsers(c, users); err != nil {
+		return err
+	}
+	k := 0
+	for i := range conversations {
+		for j := range conversations[i].Members {
+			conversations[i].Members[j].User = users[k]
+			k++
+		}
+	}
+
+	return nil
+}
+
+// findConversation loads the conversation named by the id path parameter if
+// userId is one of its members and no member is blocked, writing a 404 or
+// 500 response if it cannot
+func findConversation(c *gin.Context, userId string) (*db.Conversation, bool) {
+	var conversation db.Conversation
+	result := visibleConversations(db.GetDB().Where("id = ?", c.Param("id")), userId).First(&conversation)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Conversation not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch conversation"})
+		return nil, false
+	}
+	return &conversation, true
+}
+
+// visibleConversations restricts a conversations query to those userId is a
+// member of, leaving out any with a user they have blocked or been blocked by
+func visibleConversations(query *gorm.DB, userId string) *gorm.DB {
+	return query.
+		Where("id IN (?)", db.GetDB().Model(&db.ConversationMember{}).Select("conversation_id").Where("user_id = ?", userId)).
+		Where("id NOT IN (?)", db.GetDB().Model(&db.ConversationMember{}).Select("conversation_id").
+			Where("user_id IN (?)", blockedIdsOf(userId)))
+}
+
+// conversationPairKey identifies the conversation between two users
+// regardless of which of them started it
+func conversationPairKey(userId, otherId string) string {
+	if userId > otherId {
+		userId, otherId = otherId, userId
+	}
+	return userId + ":" + otherId
+}
@@ -0,0 +1,183 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+type MutesResponse struct {
+	Users []db.User `json:"users"`
+	Total int64     `json:"total"`
+	Page  int       `json:"page"`
+	Limit int       `json:"limit"`
+}
+
+// GetMutedUsers godoc
+// @Summary Get muted users
+// @Description Returns a paginated list of users the authenticated user has muted, most recent first
+// @Tags Mutes
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} MutesResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/mutes [get]
+func GetMutedUsers(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	var total int64
+	db.GetDB().Model(&db.Mute{}).Where("muter_id = ?", userId).Count(&total)
+
+	var mutes []db.Mute
+	if err := db.GetDB().Where("muter_id = ?", userId).Preload("Muted").
+		Order("created_at DESC").Offset(offset).Limit(limit).Find(&mutes).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch muted users"})
+		return
+	}
+
+	users := make([]db.User, 0, len(mutes))
+	for _, mute := range mutes {
+		users = append(users, mute.Muted)
+	}
+
+	c.JSON(http.StatusOK, MutesResponse{
+		Users: users,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	})
+}
+
+// MuteUser godoc
+// @Summary Mute a user
+// @Description Mutes a user, hiding their activity from the authenticated user's feed and notifications. Follows are unaffected and the muted user is not told.
+// @Tags Mutes
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the user to mute"
+// @Success 201 {object} db.Mute
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/mutes/{username} [post]
+func MuteUser(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	username := c.Param("username")
+	var user db.User
+	result := db.GetDB().Where("username = ?", username).First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+
+	if user.Id == userId.(string) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot mute yourself"})
+		return
+	}
+
+	var existing db.Mute
+	err := db.GetDB().Where("muter_id = ? AND muted_id = ?", userId, user.Id).First(&existing).Error
+	if err == nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "Already muting this user"})
+		return
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check mute status"})
+		return
+	}
+
+	mute := db.Mute{
+		MuterId: userId.(string),
+		MutedId: user.Id,
+	}
+	if err := db.GetDB().Create(&mute).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mute user"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, mute)
+}
+
+// UnmuteUser godoc
+// @Summary Unmute a user
+// @Description Removes the authenticated user's mute of a user
+// @Tags Mutes
+// @Accept json
+// @Produce json
+// @Param username path string true "Username of the user to unmute"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/mutes/{username} [delete]
+func UnmuteUser(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	username := c.Param("username")
+	var user db.User
+	result := db.GetDB().Where("username = ?", username).First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+
+	deleted := db.GetDB().Where("muter_id = ? AND muted_id = ?", userId, user.Id).Delete(&db.Mute{})
+	if deleted.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmute user"})
+		return
+	}
+	if deleted.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not muting this user"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User unmuted successfully"})
+}
+
+// mutedIdsOf selects the ids of the users userId has muted
+func mutedIdsOf(userId string) *gorm.DB {
+	return db.GetDB().Model(&db.Mute{}).Select("muted_id").Where("muter_id = ?", userId)
+}
@@ -0,0 +1,393 @@
+package v1
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationResponse struct {
+	Notifications []db.Notification `json:"notifications"`
+	Total         int64             `json:"total"`
+	Page          int               `json:"page"`
+	Limit         int               `json:"limit"`
+}
+
+// UnreadCountResponse represents the number of unread notifications
+type UnreadCountResponse struct {
+	Count int64 `json:"count" example:"3"`
+}
+
+// NotificationPreferenceItem represents whether one notification type is received
+type NotificationPreferenceItem struct {
+	Type    string `json:"type" binding:"required" example:"follow"`
+	Enabled bool   `json:"enabled" example:"false"`
+}
+
+// UpdateNotificationPreferencesRequest represents the request body for
+// updating notification preferences. Types not listed are left unchanged.
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []NotificationPreferenceItem `json:"preferences" binding:"required,dive"`
+}
+
+// GetNotifications godoc
+// @Summary Get notifications
+// @Description Returns a paginated, newest-first list of the authenticated user's notifications. Notifications from muted or blocked users are left out.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param unread query bool false "Only return unread notifications"
+// @Success 200 {object} NotificationResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /notifications [get]
+func GetNotifications(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	unreadOnly := c.Query("unread") == "true"
+
+	countQuery := visibleNotifications(userId.(string))
+	listQuery := visibleNotifications(userId.(string))
+	if unreadOnly {
+		countQuery = countQuery.Where("read_at IS NULL")
+		listQuery = listQuery.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := countQuery.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch notifications"})
+		return
+	}
+
+	notifications := []db.Notification{}
+	if err := listQuery.Preload("Actor").Order("created_at DESC, id DESC").
+		Offset(offset).Limit(limit).Find(&notifications).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch notifications"})
+		return
+	}
+
+	c.JSON(http.StatusOK, NotificationResponse{
+		Notifications: notifications,
+		Total:         total,
+		Page:          page,
+		Limit:         limit,
+	})
+}
+
+// GetUnreadNotificationCount godoc
+// @Summary Get unread notification count
+// @Description Returns the number of unread notifications for the authenticated user
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} UnreadCountResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/unread-count [get]
+func GetUnreadNotificationCount(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var count int64
+	if err := visibleNotifications(userId.(string)).Where("read_at IS NULL").
+		Count(&count).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count notifications"})
+		return
+	}
+
+	c.JSON(http.StatusOK, UnreadCountResponse{Count: count})
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark notification as read
+// @Description Marks one of the authenticated user's notifications as read. Marking an already read notification keeps its original read time.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 200 {object} db.Notification
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/{id}/read [post]
+func MarkNotificationRead(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	notificationId := c.Param("id")
+
+	var notification db.Notification
+	result := db.GetDB().Where("id = ? AND user_id = ?", notificationId, userId).First(&notification)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Notification not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch notification"})
+		return
+	}
+
+	if notification.ReadAt == nil {
+		now := time.Now()
+		if err := db.GetDB().Model(&notification).Update("read_at", now).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark notification as read"})
+			return
+		}
+		notification.ReadAt = &now
+	}
+
+	c.JSON(http.StatusOK, notification)
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark all notifications as read
+// @Description Marks every unread notification of the authenticated user as read
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/read-all [post]
+func MarkAllNotificationsRead(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	if err := db.GetDB().Model(&db.Notification{}).Where("user_id = ? AND read_at IS NULL", userId).
+		Update("read_at", time.Now()).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark notifications as read"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "All notifications marked as read"})
+}
+
+// GetNotificationPreferences godoc
+// @Summary Get notification preferences
+// @Description Returns whether the authenticated user receives each notification type
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Success 200 {array} NotificationPreferenceItem
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/preferences [get]
+func GetNotificationPreferences(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	preferences, err := notificationPreferences(userId.(string))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch notification preferences"})
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Update notification preferences
+// @Description Turns notification types on or off for the authenticated user. Types not included are left unchanged.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param request body UpdateNotificationPreferencesRequest true "Notification preferences"
+// @Success 200 {array} NotificationPreferenceItem
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/preferences [put]
+func UpdateNotificationPreferences(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rows := make([]db.NotificationPreference, 0, len(req.Preferences))
+	for _, item := range req.Preferences {
+		if !slices.Contains(db.NotificationTypes, item.Type) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown notification type: " + item.Type})
+			return
+		}
+		rows = append(rows, db.NotificationPreference{
+			UserId:  userId.(string),
+			Type:    item.Type,
+			Enabled: item.Enabled,
+		})
+	}
+
+	if len(rows) > 0 {
+		if err := db.GetDB().Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+		}).Create(&rows).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notification preferences"})
+			return
+		}
+	}
+
+	preferences, err := notificationPreferences(userId.(string))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch notification preferences"})
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// notificationPreferences returns the user's setting for every notification
+// type, defaulting to enabled
+func notificationPreferences(userId string) ([]NotificationPreferenceItem, error) {
+	var stored []db.NotificationPreference
+	if err := db.GetDB().Where("user_id = ?", userId).Find(&stored).Error; err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[string]bool, len(stored))
+	for _, pref := range stored {
+		enabled[pref.Type] = pref.Enabled
+	}
+
+	preferences := make([]NotificationPreferenceItem, 0, len(db.NotificationTypes))
+	for _, notificationType := range db.NotificationTypes {
+		value, ok := enabled[notificationType]
+		preferences = append(preferences, NotificationPreferenceItem{
+			Type:    notificationType,
+			Enabled: !ok || value,
+		})
+	}
+
+	return preferences, nil
+}
+
+// notify creates a notification for userId about something actorId did,
+// unless the user has turned off the type, is the actor, or has muted,
+// blocked or been blocked by the actor. Like feed events, it
+// runs after the triggering change is saved, so failures are only logged.
+func notify(userId, actorId, notificationType string, entityId *string) {
+	if userId == actorId {
+		return
+	}
+
+	var muted int64
+	if err := db.GetDB().Model(&db.NotificationPreference{}).
+		Where("user_id = ? AND type = ? AND enabled = ?", userId, notificationType, false).
+		Count(&muted).Error; err != nil {
+		log.Printf("Failed to check notification preferences for user %s: %v", userId, err)
+		return
+	}
+	if muted > 0 {
+		return
+	}
+
+	var mutes int64
+	if err := db.GetDB().Model(&db.Mute{}).Where("muter_id = ? AND muted_id = ?", userId, actorId).
+		Count(&mutes).Error; err != nil {
+		log.Printf("Failed to check mutes for user %s: %v", userId, err)
+		return
+	}
+	blocked, err := isBlocked(userId, actorId)
+	if err != nil {
+		log.Printf("Failed to check blocks for user %s: %v", userId, err)
+		return
+	}
+	if mutes > 0 || blocked {
+		return
+	}
+
+	notification := db.Notification{
+		UserId:   userId,
+		ActorId:  actorId,
+		Type:     notificationType,
+		EntityId: entityId,
+	}
+	if err := db.GetDB().Create(&notification).Error; err != nil {
+		log.Printf("Failed to create %s notification for user %s: %v", notificationType, userId, err)
+	}
+}
+
+// visibleNotifications selects the user's notifications, leaving out those
+// from users they have muted, blocked or been blocked by
+func visibleNotifications(userId string) *gorm.DB {
+	return db.GetDB().Model(&db.Notification{}).Where("user_id = ?", userId).
+		Where("actor_id NOT IN (?) AND actor_id NOT IN (?)", mutedIdsOf(userId), blockedIdsOf(userId))
+}
+
+// withdrawNotification removes unread notifications for an action that was
+// undone, such as an unfollow, so they do not pile up when it is repeated.
+// A nil entityId matches every notification of the type from the actor.
+func withdrawNotification(userId, actorId, notificationType string, entityId *string) {
+	query := db.GetDB().Where("user_id = ? AND actor_id = ? AND type = ? AND read_at IS NULL", userId, actorId, notificationType)
+	if entityId != nil {
+		query = query.Where("entity_id = ?", *entityId)
+	}
+	if err := query.Delete(&db.Notification{}).Error; err != nil {
+		log.Printf("Failed to withdraw %s notification for user %s: %v", notificationType, userId, err)
+	}
+}
+
+// withdrawNotificationsOfType removes a user's unread notifications of one
+// type, for when every action they were about has been resolved at once
+func withdrawNotificationsOfType(userId, notificationType string) {
+	if err := db.GetDB().Where("user_id = ? AND type = ? AND read_at IS NULL", userId, notificationType).
+		Delete(&db.Notification{}).Error; err != nil {
+		log.Printf("Failed to withdraw %s notifications for user %s: %v", notificationType, userId, err)
+	}
+}
+
+// withdrawEntityNotifications removes unread notifications about a record
+// that has been deleted
+func withdrawEntityNotifications(entityId string) {
+	if err := db.GetDB().Where("entity_id = ? AND read_at IS NULL", entityId).
+		Delete(&db.Notification{}).Error; err != nil {
+		log.Printf("Failed to withdraw notifications for %s: %v", entityId, err)
+	}
+}
@@ -0,0 +1,376 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+// CreateCommentRequest represents the request body for commenting on a project
+type CreateCommentRequest struct {
+	Body     string  `json:"body" binding:"required,max=2000" example:"Really clean architecture!"`
+	ParentId *string `json:"parentId" example:"3f1c2b7e-8a4d-4c6e-9b2a-1d5e7f9a0c3b"`
+}
+
+// UpdateCommentRequest represents the request body for editing a comment
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,max=2000" example:"Really clean architecture, nice tests too!"`
+}
+
+type CommentsResponse struct {
+	Comments []db.ProjectComment `json:"comments"`
+	Total    int64               `json:"total"`
+	Page     int                 `json:"page"`
+	Limit    int                 `json:"limit"`
+}
+
+// GetProjectComments godoc
+// @Summary Get project comments
+// @Description Returns a paginated, oldest-first list of a project's top-level comments. Each comment includes every reply in its thread, oldest first, with parentId identifying the comment it answers. Comments by users the viewer has blocked or been blocked by are left out. Deleted comments that still have replies are returned with an empty body and no author. Private profiles among the authors only show their name, image and headline to viewers who do not follow them.
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Threads per page" default(20)
+// @Success 200 {object} CommentsResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/comments [get]
+func GetProjectComments(c *gin.Context) {
+	project, ok := findProject(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	var total int64
+	hideBlocked(c, db.GetDB().Model(&db.ProjectComment{}).Where("project_id = ? AND root_id IS NULL", project.Id), "user_id").
+		Count(&total)
+
+	comments := []db.ProjectComment{}
+	if err := hideBlocked(c, db.GetDB().Where("project_id = ? AND root_id IS NULL", project.Id), "user_id").Preload("User").
+		Order("created_at ASC, id ASC").Offset(offset).Limit(limit).Find(&comments).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch comments"})
+		return
+	}
+
+	rootIds := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		rootIds = append(rootIds, comment.Id)
+	}
+
+	var replies []db.ProjectComment
+	if len(rootIds) > 0 {
+		if err := hideBlocked(c, db.GetDB().Where("project_id = ? AND root_id IN ?", project.Id, rootIds), "user_id").Preload("User").
+			Order("created_at ASC, id ASC").Find(&replies).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch comments"})
+			return
+		}
+	}
+
+	for i := range comments {
+		redactDeletedComment(&comments[i])
+	}
+	for i := range replies {
+		redactDeletedComment(&replies[i])
+	}
+
+	authors := []*db.User{}
+	for i := range comments {
+		if comments[i].User != nil {
+			authors = append(authors, comments[i].User)
+		}
+	}
+	for i := range replies {
+		if replies[i].User != nil {
+			authors = append(authors, replies[i].User)
+		}
+	}
+	users := make([]db.User, 0, len(authors))
+	for _, author := range authors {
+		users = append(users, *author)
+	}
+	if err := redactPrivateUsers(c, users); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch comments"})
+		return
+	}
+	for i, author := range authors {
+		*author = users[i]
+	}
+
+	threads := make(map[string][]db.ProjectComment, len(comments))
+	for _, reply := range replies {
+		threads[*reply.RootId] = append(threads[*reply.RootId], reply)
+	}
+	for i := range comments {
+		comments[i].Replies = threads[comments[i].Id]
+		if comments[i].Replies == nil {
+			comments[i].Replies = []db.ProjectComment{}
+		}
+	}
+
+	c.JSON(http.StatusOK, CommentsResponse{
+		Comments: comments,
+		Total:    total,
+		Page:     page,
+		Limit:    limit,
+	})
+}
+
+// CreateProjectComment godoc
+// @Summary Comment on a project
+// @Description Adds a comment to a project, or a reply when parentId names another comment on the same project
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body CreateCommentRequest true "Comment"
+// @Success 201 {object} db.ProjectComment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /projects/{id}/comments [post]
+func CreateProjectComment(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Comment cannot be empty"})
+		return
+	}
+
+	project, ok := findProject(c)
+	if !ok {
+		return
+	}
+
+	comment := db.ProjectComment{
+		ProjectId: project.Id,
+		UserId:    userId.(string),
+		Body:      body,
+	}
+
+	var parent db.ProjectComment
+	if req.ParentId != nil {
+		result := db.GetDB().Where("id = ? AND project_id = ?", *req.ParentId, project.Id).First(&parent)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Parent comment not found on this project"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch comment"})
+			return
+		}
+		if parent.DeletedAt != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot reply to a deleted comment"})
+			return
+		}
+
+		rootId := parent.Id
+		if parent.RootId != nil {
+			rootId = *parent.RootId
+		}
+		comment.ParentId = &parent.Id
+		comment.RootId = &rootId
+	}
+
+	// Blocks with the project owner are already handled by findProject
+	if comment.ParentId != nil {
+		blocked, err := isBlocked(comment.UserId, parent.UserId)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create comment"})
+			return
+		}
+		if blocked {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Cannot comment here"})
+			return
+		}
+	}
+
+	if err := db.GetDB().Create(&comment).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create comment"})
+		return
+	}
+
+	// A reply to the project owner's own comment only needs the reply
+	// notification
+	if comment.ParentId != nil {
+		notify(parent.UserId, comment.UserId, db.NotificationCommentReply, &comment.Id)
+	}
+	if comment.ParentId == nil || parent.UserId != project.UserId {
+		notify(project.UserId, comment.UserId, db.NotificationProjectComment, &comment.Id)
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+// UpdateProjectComment godoc
+// @Summary Edit a comment
+// @Description Edits the body of one of the authenticated user's comments
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param commentId path string true "Comment ID"
+// @Param request body UpdateCommentRequest true "Comment"
+// @Success 200 {object} db.ProjectComment
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /projects/{id}/comments/{commentId} [put]
+func UpdateProjectComment(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Comment cannot be empty"})
+		return
+	}
+
+	comment, ok := findProjectComment(c)
+	if !ok {
+		return
+	}
+
+	if comment.UserId != userId.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can only edit your own comments"})
+		return
+	}
+
+	if err := db.GetDB().Model(comment).Update("body", body).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update comment"})
+		return
+	}
+	comment.Body = body
+
+	c.JSON(http.StatusOK, comment)
+}
+
+// DeleteProjectComment godoc
+// @Summary Delete a comment
+// @Description Deletes a comment. Authors can delete their own comments and project owners can delete any comment on their project. A comment with replies is blanked instead of removed so the thread stays intact.
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param commentId path string true "Comment ID"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /projects/{id}/comments/{commentId} [delete]
+func DeleteProjectComment(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	project, ok := findProject(c)
+	if !ok {
+		return
+	}
+
+	comment, ok := findProjectComment(c)
+	if !ok {
+		return
+	}
+
+	if comment.UserId != userId.(string) && project.UserId != userId.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can only delete your own comments or comments on your projects"})
+		return
+	}
+
+	var replies int64
+	if err := db.GetDB().Model(&db.ProjectComment{}).Where("parent_id = ?", comment.Id).Count(&replies).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete comment"})
+		return
+	}
+
+	var err error
+	if replies > 0 {
+		err = db.GetDB().Model(comment).Updates(map[string]interface{}{
+			"body":       "",
+			"deleted_at": time.Now(),
+		}).Error
+	} else {
+		err = db.GetDB().Delete(comment).Error
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete comment"})
+		return
+	}
+
+	withdrawEntityNotifications(comment.Id)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Comment deleted successfully"})
+}
+
+// findProjectComment loads the live comment named by the commentId path
+// parameter on the project named by id, writing a 404 or 500 response if it
+// cannot
+func findProjectComment(c *gin.Context) (*db.ProjectComment, bool) {
+	var comment db.ProjectComment
+	result := db.GetDB().Where("id = ? AND project_id = ? AND deleted_at IS NULL", c.Param("commentId"), c.Param("id")).
+		First(&comment)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch comment"})
+		return nil, false
+	}
+	return &comment, true
+}
+
+// redactDeletedComment hides who wrote a deleted comment kept for its replies
+func redactDeletedComment(comment *db.ProjectComment) {
+	if comment.DeletedAt != nil {
+		comment.User = nil
+		comment.UserId = ""
+	}
+}
@@ -0,0 +1,236 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// errAlreadyStarred and errNotStarred let the star transactions report a
+// no-op without it being treated as a failure
+var (
+	errAlreadyStarred = errors.New("project already starred")
+	errNotStarred     = errors.New("project not starred")
+)
+
+type StargazersResponse struct {
+	Users []db.User `json:"users"`
+	Total int64     `json:"total"`
+	Page  int       `json:"page"`
+	Limit int       `json:"limit"`
+}
+
+// StarProject godoc
+// @Summary Star a project
+// @Description Stars a project for the authenticated user
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 201 {object} db.ProjectStar
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /projects/{id}/star [post]
+func StarProject(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	project, ok := findProject(c)
+	if !ok {
+		return
+	}
+
+	star := db.ProjectStar{
+		ProjectId: project.Id,
+		UserId:    userId.(string),
+	}
+
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		// A concurrent star by the same user conflicts on the primary key
+		// rather than failing the insert
+		created := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&star)
+		if created.Error != nil {
+			return created.Error
+		}
+		if created.RowsAffected == 0 {
+			return errAlreadyStarred
+		}
+		return tx.Model(&db.Project{}).Where("id = ?", project.Id).
+			Update("star_count", gorm.Expr("star_count + 1")).Error
+	})
+	if err != nil {
+		if errors.Is(err, errAlreadyStarred) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Already starred this project"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to star project"})
+		return
+	}
+
+	notify(project.UserId, star.UserId, db.NotificationProjectStar, &project.Id)
+
+	c.JSON(http.StatusCreated, star)
+}
+
+// UnstarProject godoc
+// @Summary Unstar a project
+// @Description Removes the authenticated user's star from a project
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /projects/{id}/star [delete]
+func UnstarProject(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	project, ok := findProject(c)
+	if !ok {
+		return
+	}
+
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		deleted := tx.Where("project_id = ? AND user_id = ?", project.Id, userId).Delete(&db.ProjectStar{})
+		if deleted.Error != nil {
+			return deleted.Error
+		}
+		if deleted.RowsAffected == 0 {
+			return errNotStarred
+		}
+		return tx.Model(&db.Project{}).Where("id = ?", project.Id).
+			Update("star_count", gorm.Expr("GREATEST(star_count - 1, 0)")).Error
+	})
+	if err != nil {
+		if errors.Is(err, errNotStarred) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Not starring this project"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unstar project"})
+		return
+	}
+
+	withdrawNotification(project.UserId, userId.(string), db.NotificationProjectStar, &project.Id)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Project unstarred successfully"})
+}
+
+// GetStargazers godoc
+// @Summary Get stargazers
+// @Description Returns a paginated list of users who starred a project, most recent first
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} StargazersResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/stargazers [get]
+func GetStargazers(c *gin.Context) {
+	project, ok := findProject(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	var total int64
+	hideBlocked(c, db.GetDB().Model(&db.ProjectStar{}).Where("project_id = ?", project.Id), "user_id").Count(&total)
+
+	var stars []db.ProjectStar
+	if err := hideBlocked(c, db.GetDB().Where("project_id = ?", project.Id), "user_id").Preload("User").
+		Order("created_at DESC").Offset(offset).Limit(limit).Find(&stars).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch stargazers"})
+		return
+	}
+
+	users := make([]db.User, 0, len(stars))
+	for _, star := range stars {
+		users = append(users, star.User)
+	}
+	if err := redactPrivateUsers(c, users); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch stargazers"})
+		return
+	}
+
+	c.JSON(http.StatusOK, StargazersResponse{
+		Users: users,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	})
+}
+
+// findProject loads the project named by the id path parameter, writing a
+// 404 or 500 response if it cannot or its owner and the viewer have blocked
+// each other, or a 403 if its owner's profile is private to the viewer
+func findProject(c *gin.Context) (*db.Project, bool) {
+	var project db.Project
+	result := db.GetDB().Where("id = ?", c.Param("id")).First(&project)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch project"})
+		return nil, false
+	}
+
+	hidden, err := hiddenByBlock(c, project.UserId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch project"})
+		return nil, false
+	}
+	if hidden {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+		return nil, false
+	}
+
+	var owner db.User
+	if err := db.GetDB().Select("id", "visibility").Where("id = ?", project.UserId).First(&owner).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch project"})
+		return nil, false
+	}
+	visible, err := canViewProfile(c, &owner)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch project"})
+		return nil, false
+	}
+	if !visible {
+		c.JSON(http.StatusForbidden, gin.H{"error": "This profile is private"})
+		return nil, false
+	}
+
+	return &project, true
+}
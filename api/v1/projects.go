@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/services"
 	"gorm.io/gorm"
 )
 
@@ -35,7 +36,9 @@ type UpdateProjectRequest struct {
 // @Tags Projects
 // @Accept json
 // @Produce json
+// @Param sort query string false "Sort order" Enums(newest, stars)
 // @Success 200 {array} db.Project
+// @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Security BearerAuth
@@ -47,8 +50,51 @@ func GetMyProjects(c *gin.Context) {
 		return
 	}
 
+	order, ok := projectOrder(c.DefaultQuery("sort", "newest"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort must be newest or stars"})
+		return
+	}
+
+	var projects []db.Project
+	if err := db.GetDB().Where("user_id = ?", userId).Order(order).Find(&projects).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch projects"})
+		return
+	}
+
+	c.JSON(http.StatusOK, projects)
+}
+
+// GetUserProjects godoc
+// @Summary Get user's projects
+// @Description Returns all projects of a user
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Param sort query string false "Sort order" Enums(newest, stars)
+// @Success 200 {array} db.Project
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{username}/projects [get]
+func GetUserProjects(c *gin.Context) {
+	username := c.Param("username")
+
+	order, ok := projectOrder(c.DefaultQuery("sort", "newest"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort must be newest or stars"})
+		return
+	}
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
 	var projects []db.Project
-	if err := db.GetDB().Where("user_id = ?", userId).Find(&projects).Error; err != nil {
+	if err := db.GetDB().Where("user_id = ?", user.Id).Order(order).Find(&projects).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch projects"})
 		return
 	}
@@ -56,6 +102,18 @@ func GetMyProjects(c *gin.Context) {
 	c.JSON(http.StatusOK, projects)
 }
 
+// projectOrder returns the ORDER BY clause for a project sort option
+func projectOrder(sort string) (string, bool) {
+	switch sort {
+	case "newest":
+		return "created_at DESC", true
+	case "stars":
+		return "star_count DESC, created_at DESC", true
+	default:
+		return "", false
+	}
+}
+
 // CreateProject godoc
 // @Summary Create project
 // @Description Creates a new project for the authenticated user
@@ -97,6 +155,11 @@ func CreateProject(c *gin.Context) {
 		return
 	}
 
+	recordEvent(project.UserId, db.EventProjectAdded, db.EventPayload{
+		"projectId": project.Id,
+		"name":      project.Name,
+	})
+
 	c.JSON(http.StatusCreated, project)
 }
 
@@ -148,6 +211,18 @@ func UpdateProject(c *gin.Context) {
 	}
 	if req.GitHubURL != nil {
 		updates["git_hub_url"] = *req.GitHubURL
+
+		// Synced metadata belongs to the old repository; clear it so the
+		// background sync picks the project up again
+		if project.GitHubURL == nil || *project.GitHubURL != *req.GitHubURL {
+			for _, column := range []string{
+				"repo_full_name", "repo_stars", "repo_forks", "repo_pushed_at",
+				"repo_license", "repo_topics", "repo_status", "repo_synced_at",
+			} {
+				updates[column] = nil
+			}
+			updates["repo_is_archived"] = false
+		}
 	}
 	if req.PrimaryLanguage != nil {
 		updates["primary_language"] = *req.PrimaryLanguage
@@ -176,7 +251,7 @@ func UpdateProject(c *gin.Context) {
 
 // DeleteProject godoc
 // @Summary Delete project
-// @Description Deletes a project
+// @Description Deletes a project along with its stars and comments
 // @Tags Projects
 // @Accept json
 // @Produce json
@@ -196,16 +271,94 @@ func DeleteProject(c *gin.Context) {
 
 	projectId := c.Param("id")
 
-	result := db.GetDB().Where("id = ? AND user_id = ?", projectId, userId).Delete(&db.Project{})
-	if result.Error != nil {
+	var commentIds []string
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND user_id = ?", projectId, userId).Delete(&db.Project{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Where("project_id = ?", projectId).Delete(&db.ProjectStar{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&db.ProjectComment{}).Where("project_id = ?", projectId).
+			Pluck("id", &commentIds).Error; err != nil {
+			return err
+		}
+		return tx.Where("project_id = ?", projectId).Delete(&db.ProjectComment{}).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete project"})
 		return
 	}
 
-	if result.RowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
-		return
+	removeEvents(userId.(string), db.EventProjectAdded, "projectId", projectId)
+	withdrawEntityNotifications(projectId)
+	for _, commentId := range commentIds {
+		withdrawEntityNotifications(commentId)
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Project deleted successfully"})
 }
+
+// SyncProject godoc
+// @Summary Refresh project repository metadata
+// @Description Refreshes stars, forks, last push date, license, archived status and topics from the project's GitHub repository
+// @Tags Projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} db.Project
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/projects/{id}/sync [post]
+func SyncProject(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	projectId := c.Param("id")
+
+	var project db.Project
+	result := db.GetDB().Where("id = ? AND user_id = ?", projectId, userId).First(&project)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Project not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch project"})
+		return
+	}
+
+	if project.GitHubURL == nil || *project.GitHubURL == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Project does not have a GitHub URL"})
+		return
+	}
+
+	if _, _, err := services.ParseGitHubRepoURL(*project.GitHubURL); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := services.SyncProjectRepository(c.Request.Context(), &project); err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, project)
+}
@@ -0,0 +1,482 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+// RequestRecommendationRequest represents the request body for asking a user
+// for a recommendation
+type RequestRecommendationRequest struct {
+	Username     string  `json:"username" binding:"required" example:"janedoe"`
+	ExperienceId *string `json:"experienceId" example:"3f1c2b7e-8a4d-4c6e-9b2a-1d5e7f9a0c3b"`
+	Message      *string `json:"message" binding:"omitempty,max=1000" example:"Would you write a few words about our work on the payments team?"`
+}
+
+// WriteRecommendationRequest represents the request body for writing a
+// recommendation
+type WriteRecommendationRequest struct {
+	Body string `json:"body" binding:"required,max=5000" example:"John led our migration to event sourcing and made the whole team better engineers."`
+}
+
+type RecommendationsResponse struct {
+	Recommendations []db.Recommendation `json:"recommendations"`
+	Total           int64               `json:"total"`
+	Page            int                 `json:"page"`
+	Limit           int                 `json:"limit"`
+}
+
+// RequestRecommendation godoc
+// @Summary Request a recommendation
+// @Description Asks a user to write a recommendation for the authenticated user, optionally about one of their experience entries. Only one request to each user can be awaiting a recommendation or approval at a time.
+// @Tags Recommendations
+// @Accept json
+// @Produce json
+// @Param request body RequestRecommendationRequest true "Recommender and optional experience and note"
+// @Success 201 {object} db.Recommendation
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /recommendations [post]
+func RequestRecommendation(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req RequestRecommendationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	recommender, ok := findUserByUsername(c, req.Username)
+	if !ok {
+		return
+	}
+	if recommender.Id == userId.(string) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot request a recommendation from yourself"})
+		return
+	}
+
+	if req.ExperienceId != nil {
+		var count int64
+		if err := db.GetDB().Model(&db.Experience{}).Where("id = ? AND user_id = ?", *req.ExperienceId, userId).
+			Count(&count).Error; err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch experience"})
+			return
+		}
+		if count == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Experience not found on your profile"})
+			return
+		}
+	}
+
+	var pending int64
+	if err := db.GetDB().Model(&db.Recommendation{}).
+		Where("user_id = ? AND recommender_id = ? AND status IN ?", userId, recommender.Id,
+			[]string{db.RecommendationRequested, db.RecommendationSubmitted}).
+		Count(&pending).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check recommendations"})
+		return
+	}
+	if pending > 0 {
+		c.JSON(http.StatusConflict, gin.H{"error": "Already awaiting a recommendation from this user"})
+		return
+	}
+
+	recommendation := db.Recommendation{
+		UserId:        userId.(string),
+		RecommenderId: recommender.Id,
+		ExperienceId:  req.ExperienceId,
+		Status:        db.RecommendationRequested,
+		Message:       req.Message,
+	}
+	if err := db.GetDB().Create(&recommendation).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to request recommendation"})
+		return
+	}
+
+	notify(recommender.Id, userId.(string), db.NotificationRecommendationRequest, &recommendation.Id)
+
+	c.JSON(http.StatusCreated, recommendation)
+}
+
+// GetReceivedRecommendations godoc
+// @Summary Get received recommendations
+// @Description Returns a paginated list of the recommendations the authenticated user has requested or been given, most recently updated first, including those awaiting approval or hidden from their profile
+// @Tags Recommendations
+// @Accept json
+// @Produce json
+// @Param status query string false "Filter by status" Enums(requested, submitted, approved, hidden)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} RecommendationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /recommendations/received [get]
+func GetReceivedRecommendations(c *gin.Context) {
+	listRecommendations(c, "user_id", "Recommender")
+}
+
+// GetGivenRecommendations godoc
+// @Summary Get given recommendations
+// @Description Returns a paginated list of the recommendations the authenticated user has been asked for or has written, most recently updated first
+// @Tags Recommendations
+// @Accept json
+// @Produce json
+// @Param status query string false "Filter by status" Enums(requested, submitted, approved, hidden)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} RecommendationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /recommendations/given [get]
+func GetGivenRecommendations(c *gin.Context) {
+	listRecommendations(c, "recommender_id", "User")
+}
+
+// WriteRecommendation godoc
+// @Summary Write a recommendation
+// @Description Writes or edits a recommendation the authenticated user was asked for. It is sent to the recommended user for approval, and editing one they have already approved takes it off their profile until they approve it again.
+// @Tags Recommendations
+// @Accept json
+// @Produce json
+// @Param id path string true "Recommendation ID"
+// @Param request body WriteRecommendationRequest true "Recommendation text"
+// @Success 200 {object} db.Recommendation
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /recommendations/{id} [put]
+func WriteRecommendation(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req WriteRecommendationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Recommendation cannot be empty"})
+		return
+	}
+
+	recommendation, ok := findRecommendation(c, userId.(string))
+	if !ok {
+		return
+	}
+	if recommendation.RecommenderId != userId.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the recommender can write a recommendation"})
+		return
+	}
+
+	firstDraft := recommendation.Status == db.RecommendationRequested
+	now := time.Now()
+	if err := db.GetDB().Model(recommendation).Updates(map[string]interface{}{
+		"body":         body,
+		"status":       db.RecommendationSubmitted,
+		"submitted_at": now,
+		"approved_at":  nil,
+	}).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to write recommendation"})
+		return
+	}
+	recommendation.Body = &body
+	recommendation.Status = db.RecommendationSubmitted
+	recommendation.SubmittedAt = &now
+	recommendation.ApprovedAt = nil
+
+	if firstDraft {
+		withdrawNotification(userId.(string), recommendation.UserId, db.NotificationRecommendationRequest, &recommendation.Id)
+	}
+	notify(recommendation.UserId, userId.(string), db.NotificationRecommendationSubmitted, &recommendation.Id)
+
+	c.JSON(http.StatusOK, recommendation)
+}
+
+// ApproveRecommendation godoc
+// @Summary Approve a recommendation
+// @Description Shows a recommendation written for the authenticated user on their profile. Hidden recommendations can be approved again to show them.
+// @Tags Recommendations
+// @Accept json
+// @Produce json
+// @Param id path string true "Recommendation ID"
+// @Success 200 {object} db.Recommendation
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /recommendations/{id}/approve [post]
+func ApproveRecommendation(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	recommendation, ok := findRecommendation(c, userId.(string))
+	if !ok {
+		return
+	}
+	if recommendation.UserId != userId.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the recommended user can approve a recommendation"})
+		return
+	}
+
+	switch recommendation.Status {
+	case db.RecommendationRequested:
+		c.JSON(http.StatusConflict, gin.H{"error": "Recommendation has not been written yet"})
+		return
+	case db.RecommendationApproved:
+		c.JSON(http.StatusConflict, gin.H{"error": "Recommendation already approved"})
+		return
+	}
+
+	// Showing a hidden recommendation again keeps its place on the profile
+	approvedAt := time.Now()
+	if recommendation.ApprovedAt != nil {
+		approvedAt = *recommendation.ApprovedAt
+	}
+	if err := db.GetDB().Model(recommendation).Updates(map[string]interface{}{
+		"status":      db.RecommendationApproved,
+		"approved_at": approvedAt,
+	}).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to approve recommendation"})
+		return
+	}
+	recommendation.Status = db.RecommendationApproved
+	recommendation.ApprovedAt = &approvedAt
+
+	withdrawNotification(userId.(string), recommendation.RecommenderId, db.NotificationRecommendationSubmitted, &recommendation.Id)
+
+	c.JSON(http.StatusOK, recommendation)
+}
+
+// HideRecommendation godoc
+// @Summary Hide a recommendation
+// @Description Takes an approved recommendation off the authenticated user's profile without deleting it. The recommender is not told.
+// @Tags Recommendations
+// @Accept json
+// @Produce json
+// @Param id path string true "Recommendation ID"
+// @Success 200 {object} db.Recommendation
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /recommendations/{id}/hide [post]
+func HideRecommendation(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	recommendation, ok := findRecommendation(c, userId.(string))
+	if !ok {
+		return
+	}
+	if recommendation.UserId != userId.(string) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the recommended user can hide a recommendation"})
+		return
+	}
+	if recommendation.Status != db.RecommendationApproved {
+		c.JSON(http.StatusConflict, gin.H{"error": "Only approved recommendations can be hidden"})
+		return
+	}
+
+	if err := db.GetDB().Model(recommendation).Update("status", db.RecommendationHidden).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to hide recommendation"})
+		return
+	}
+	recommendation.Status = db.RecommendationHidden
+
+	c.JSON(http.StatusOK, recommendation)
+}
+
+// DeleteRecommendation godoc
+// @Summary Delete a recommendation
+// @Description Deletes a recommendation. The recommended user can remove one at any time, including cancelling a request, and the recommender can decline a request or withdraw what they wrote.
+// @Tags Recommendations
+// @Accept json
+// @Produce json
+// @Param id path string true "Recommendation ID"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /recommendations/{id} [delete]
+func DeleteRecommendation(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	recommendation, ok := findRecommendation(c, userId.(string))
+	if !ok {
+		return
+	}
+
+	if err := db.GetDB().Delete(recommendation).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete recommendation"})
+		return
+	}
+
+	withdrawEntityNotifications(recommendation.Id)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Recommendation deleted successfully"})
+}
+
+// listRecommendations writes a page of the authenticated user's
+// recommendations, matching them on column and preloading the other party
+func listRecommendations(c *gin.Context, column, party string) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	status := c.Query("status")
+	switch status {
+	case "", db.RecommendationRequested, db.RecommendationSubmitted, db.RecommendationApproved, db.RecommendationHidden:
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	query := func() *gorm.DB {
+		q := db.GetDB().Model(&db.Recommendation{}).Where(column+" = ?", userId)
+		if status != "" {
+			q = q.Where("status = ?", status)
+		}
+		return q
+	}
+
+	var total int64
+	query().Count(&total)
+
+	recommendations := []db.Recommendation{}
+	if err := query().Preload(party).Preload("Experience").
+		Order("updated_at DESC").Offset(offset).Limit(limit).Find(&recommendations).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch recommendations"})
+		return
+	}
+
+	if err := redactRecommendationUsers(c, recommendations); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch recommendations"})
+		return
+	}
+
+	c.JSON(http.StatusOK, RecommendationsResponse{
+		Recommendations: recommendations,
+		Total:           total,
+		Page:            page,
+		Limit:           limit,
+	})
+}
+
+// loadRecommendations fills in the user's approved recommendations for their
+// profile, newest first, leaving out recommenders the viewer has blocked or
+// been blocked by
+func loadRecommendations(c *gin.Context, user *db.User) error {
+	query := db.GetDB().Where("user_id = ? AND status = ?", user.Id, db.RecommendationApproved)
+	recommendations := []db.Recommendation{}
+	if err := hideBlocked(c, query, "recommender_id").Preload("Recommender").Preload("Experience").
+		Order("approved_at DESC").Find(&recommendations).Error; err != nil {
+		return err
+	}
+	if err := redactRecommendationUsers(c, recommendations); err != nil {
+		return err
+	}
+
+	user.Recommendations = recommendations
+	return nil
+}
+
+// redactRecommendationUsers redacts the loaded recommenders and recommended
+// users whose profiles are private to the request's viewer
+func redactRecommendationUsers(c *gin.Context, recommendations []db.Recommendation) error {
+	users := []db.User{}
+	for _, recommendation := range recommendations {
+		if recommendation.Recommender.Id != "" {
+			users = append(users, recommendation.Recommender)
+		}
+		if recommendation.User != nil {
+			users = append(users, *recommendation.User)
+		}
+	}
+	if err := redactPrivateUsers(c, users); err != nil {
+		return err
+	}
+
+	i := 0
+	for j := range recommendations {
+		if recommendations[j].Recommender.Id != "" {
+			recommendations[j].Recommender = users[i]
+			i++
+		}
+		if recommendations[j].User != nil {
+			*recommendations[j].User = users[i]
+			i++
+		}
+	}
+	return nil
+}
+
+// findRecommendation loads the recommendation named by the id path parameter
+// that userId either wrote or is the subject of, writing a 404 or 500
+// response if it cannot
+func findRecommendation(c *gin.Context, userId string) (*db.Recommendation, bool) {
+	var recommendation db.Recommendation
+	result := db.GetDB().Where("id = ? AND (user_id = ? OR recommender_id = ?)", c.Param("id"), userId, userId).
+		First(&recommendation)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Recommendation not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch recommendation"})
+		return nil, false
+	}
+	return &recommendation, true
+}
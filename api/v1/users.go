@@ -2,14 +2,18 @@ package v1
 
 import (
 	"errors"
+	"log"
 	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/services"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CreateUserRequest represents the request body for creating a user
@@ -29,8 +33,18 @@ type UpdateUserRequest struct {
 	GitHubUsername   *string `json:"githubUsername" example:"johndoe"`
 	LeetCodeUsername *string `json:"leetcodeUsername" example:"johndoe"`
 	LinkedInUsername *string `json:"linkedinUsername" example:"johndoe"`
+	CodeforcesHandle *string `json:"codeforcesHandle" example:"johndoe"`
+	GitLabUsername   *string `json:"gitlabUsername" example:"johndoe"`
+	GitLabURL        *string `json:"gitlabUrl" example:"https://gitlab.example.com"`
+	StackOverflowId  *string `json:"stackoverflowId" example:"22656"`
+	TimeZone         *string `json:"timeZone" example:"America/New_York"`
+	Visibility       *string `json:"visibility" binding:"omitempty,oneof=public private" example:"private"`
+	AllowMessages    *string `json:"allowMessages" binding:"omitempty,oneof=anyone followers mutuals" example:"followers"`
 }
 
+// stackOverflowIdPattern matches a numeric Stack Exchange user id
+var stackOverflowIdPattern = regexp.MustCompile(`^[0-9]+$`)
+
 // UpdateSkillsRequest represents the request body for updating skills
 type UpdateSkillsRequest struct {
 	Skills []string `json:"skills" binding:"required" example:"Go,TypeScript,React"`
@@ -48,7 +62,7 @@ type MessageResponse struct {
 
 // GetUsers godoc
 // @Summary List users
-// @Description Returns a paginated list of users with optional search and skill filters
+// @Description Returns a paginated list of users with optional search and skill filters. Private profiles are only listed for their followers.
 // @Tags Users
 // @Accept json
 // @Produce json
@@ -56,7 +70,10 @@ type MessageResponse struct {
 // @Param limit query int false "Items per page" default(20)
 // @Param search query string false "Search by name, username, or headline"
 // @Param skill query string false "Filter by skill"
+// @Param verified query bool false "Exclude users with unverified GitHub or LeetCode links"
+// @Param sort query string false "Sort order: newest (default) or endorsements, which ranks by endorsements of the skill filter, or of all skills without one" Enums(newest, endorsements)
 // @Success 200 {array} db.User
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /users [get]
 func GetUsers(c *gin.Context) {
@@ -64,6 +81,8 @@ func GetUsers(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
 	search := c.Query("search")
 	skill := c.Query("skill")
+	verifiedOnly := c.Query("verified") == "true"
+	sortBy := c.DefaultQuery("sort", "newest")
 
 	if page < 1 {
 		page = 1
@@ -74,7 +93,7 @@ func GetUsers(c *gin.Context) {
 
 	offset := (page - 1) * limit
 
-	query := db.GetDB().Model(&db.User{})
+	query := visibleProfiles(c, hideBlocked(c, db.GetDB().Model(&db.User{}), "id"))
 
 	if search != "" {
 		pattern := "%" + strings.ToLower(search) + "%"
@@ -88,8 +107,30 @@ func GetUsers(c *gin.Context) {
 		query = query.Where("? = ANY(skills)", skill)
 	}
 
+	if verifiedOnly {
+		query = query.Where("git_hub_username IS NULL OR git_hub_verified = ?", true).
+			Where("leet_code_username IS NULL OR leet_code_verified = ?", true)
+	}
+
+	var order interface{} = "created_at DESC"
+	switch sortBy {
+	case "newest":
+	case "endorsements":
+		if skill != "" {
+			order = clause.OrderBy{Expression: clause.Expr{
+				SQL:  "(SELECT COUNT(*) FROM endorsements WHERE endorsements.user_id = users.id AND LOWER(endorsements.skill) = LOWER(?)) DESC, created_at DESC",
+				Vars: []interface{}{skill},
+			}}
+		} else {
+			order = "(SELECT COUNT(*) FROM endorsements WHERE endorsements.user_id = users.id) DESC, created_at DESC"
+		}
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort must be newest or endorsements"})
+		return
+	}
+
 	var users []db.User
-	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&users).Error; err != nil {
+	if err := query.Order(order).Offset(offset).Limit(limit).Find(&users).Error; err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch users"})
 		return
 	}
@@ -99,7 +140,7 @@ func GetUsers(c *gin.Context) {
 
 // GetUserByUsername godoc
 // @Summary Get user by username
-// @Description Returns a user's public profile by username with projects, education, and experience
+// @Description Returns a user's public profile by username with projects, education, experience, skill endorsements and approved recommendations. A private profile only shows its name, image and headline to anyone but the user and their followers.
 // @Tags Users
 // @Accept json
 // @Produce json
@@ -124,6 +165,36 @@ func GetUserByUsername(c *gin.Context) {
 		return
 	}
 
+	hidden, err := hiddenByBlock(c, user.Id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+	if hidden {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	visible, err := canViewProfile(c, &user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return
+	}
+	if !visible {
+		redactProfile(&user)
+		c.JSON(http.StatusOK, user)
+		return
+	}
+
+	if err := loadEndorsements(c, &user); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch endorsements"})
+		return
+	}
+	if err := loadRecommendations(c, &user); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch recommendations"})
+		return
+	}
+
 	c.JSON(http.StatusOK, user)
 }
 
@@ -275,21 +346,92 @@ func UpdateCurrentUser(c *gin.Context) {
 	if req.Resume != nil {
 		updates["resume"] = nilIfEmpty(req.Resume)
 	}
+	// Changing a linked account invalidates its verification
+	var resetProviders []string
 	if req.GitHubUsername != nil {
 		updates["git_hub_username"] = nilIfEmpty(req.GitHubUsername)
+		if *req.GitHubUsername != services.StringValue(user.GitHubUsername) {
+			updates["git_hub_verified"] = false
+			resetProviders = append(resetProviders, db.VerificationProviderGitHub)
+		}
 	}
 	if req.LeetCodeUsername != nil {
 		updates["leet_code_username"] = nilIfEmpty(req.LeetCodeUsername)
+		if *req.LeetCodeUsername != services.StringValue(user.LeetCodeUsername) {
+			updates["leet_code_verified"] = false
+			resetProviders = append(resetProviders, db.VerificationProviderLeetCode)
+		}
 	}
 	if req.LinkedInUsername != nil {
 		updates["linked_in_username"] = nilIfEmpty(req.LinkedInUsername)
 	}
+	if req.CodeforcesHandle != nil {
+		updates["codeforces_handle"] = nilIfEmpty(req.CodeforcesHandle)
+	}
+	if req.GitLabUsername != nil {
+		updates["git_lab_username"] = nilIfEmpty(req.GitLabUsername)
+	}
+	if req.GitLabURL != nil {
+		// An empty instance URL means gitlab.com
+		if *req.GitLabURL == "" {
+			updates["git_lab_url"] = nil
+		} else {
+			instanceURL, err := services.NormalizeGitLabURL(c.Request.Context(), *req.GitLabURL)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			updates["git_lab_url"] = instanceURL
+		}
+	}
+	if req.StackOverflowId != nil {
+		if *req.StackOverflowId != "" && !stackOverflowIdPattern.MatchString(*req.StackOverflowId) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Stack Overflow user id must be numeric"})
+			return
+		}
+		updates["stack_overflow_id"] = nilIfEmpty(req.StackOverflowId)
+	}
+	if req.TimeZone != nil {
+		// LoadLocation treats "" as UTC, which is also the default when unset
+		if _, err := time.LoadLocation(*req.TimeZone); err != nil || *req.TimeZone == "Local" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Time zone must be a valid IANA time zone name"})
+			return
+		}
+		updates["time_zone"] = nilIfEmpty(req.TimeZone)
+	}
+	if req.AllowMessages != nil && *req.AllowMessages != "" {
+		updates["allow_messages"] = *req.AllowMessages
+	}
+	// Pending follow requests are approved when a private profile goes public
+	goingPublic := false
+	if req.Visibility != nil && *req.Visibility != "" {
+		updates["visibility"] = *req.Visibility
+		goingPublic = *req.Visibility == db.VisibilityPublic && user.Visibility == db.VisibilityPrivate
+	}
 
 	if len(updates) > 0 {
-		if err := db.GetDB().Model(&user).Updates(updates).Error; err != nil {
+		err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+			if err := tx.Model(&user).Updates(updates).Error; err != nil {
+				return err
+			}
+			if len(resetProviders) > 0 {
+				if err := tx.Where("user_id = ? AND provider IN ?", user.Id, resetProviders).
+					Delete(&db.AccountVerification{}).Error; err != nil {
+					return err
+				}
+			}
+			if goingPublic {
+				return acceptAllFollowRequests(tx, user.Id)
+			}
+			return nil
+		})
+		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
 			return
 		}
+		if goingPublic {
+			withdrawNotificationsOfType(user.Id, db.NotificationFollowRequest)
+		}
 	}
 
 	// Fetch updated user
@@ -299,7 +441,7 @@ func UpdateCurrentUser(c *gin.Context) {
 
 // DeleteCurrentUser godoc
 // @Summary Delete current user
-// @Description Deletes the authenticated user's account and all associated data
+// @Description Deletes the authenticated user's account and all associated data, including stored credentials for connected accounts. A linked GitHub authorization is revoked first.
 // @Tags Users
 // @Accept json
 // @Produce json
@@ -316,14 +458,44 @@ func DeleteCurrentUser(c *gin.Context) {
 		return
 	}
 
-	result := db.GetDB().Where("id = ?", userId).Delete(&db.User{})
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete user"})
+	// As when disconnecting, the account is deleted even if revoking the
+	// GitHub token fails
+	var github db.ConnectedAccount
+	if err := db.GetDB().Where("user_id = ? AND provider = ?", userId, db.ConnectedAccountGitHub).
+		First(&github).Error; err == nil {
+		if err := revokeGitHubToken(c, &github); err != nil {
+			log.Printf("Failed to revoke GitHub token for user %s: %v", github.UserId, err)
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch connected account"})
 		return
 	}
 
-	if result.RowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		// Stored credentials and pending verifications have no foreign key to
+		// cascade from, so they are removed alongside the user
+		if err := tx.Where("user_id = ?", userId).Delete(&db.ConnectedAccount{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userId).Delete(&db.AccountVerification{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", userId).Delete(&db.User{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete user"})
 		return
 	}
 
@@ -363,12 +535,34 @@ func UpdateSkills(c *gin.Context) {
 		return
 	}
 
+	previous := make(map[string]bool, len(user.Skills))
+	for _, skill := range user.Skills {
+		previous[strings.ToLower(skill)] = true
+	}
+
 	user.Skills = req.Skills
-	if err := db.GetDB().Save(&user).Error; err != nil {
+	err := db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&user).Error; err != nil {
+			return err
+		}
+		return removeOrphanedEndorsements(tx, user.Id, req.Skills)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update skills"})
 		return
 	}
 
+	// Only additions are announced; removing or reordering skills is not news
+	added := []string{}
+	for _, skill := range req.Skills {
+		if !previous[strings.ToLower(skill)] {
+			added = append(added, skill)
+		}
+	}
+	if len(added) > 0 {
+		recordEvent(user.Id, db.EventSkillsUpdated, db.EventPayload{"added": added})
+	}
+
 	c.JSON(http.StatusOK, user)
 }
 
@@ -0,0 +1,251 @@
+package v1
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/services"
+	"gorm.io/gorm"
+)
+
+// verificationTTL is how long a verification challenge stays valid
+const verificationTTL = 24 * time.Hour
+
+// VerificationChallengeResponse represents an issued verification challenge
+type VerificationChallengeResponse struct {
+	Provider        string    `json:"provider" example:"github"`
+	AccountUsername string    `json:"accountUsername" example:"johndoe"`
+	Token           string    `json:"token" example:"devboard-verify-3f9a1c0e5b7d4a2f8e6c1b0a9d7e5f3c"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+	Instructions    string    `json:"instructions" example:"Add the token to your GitHub bio or a public gist, then check verification"`
+}
+
+// VerificationStatusResponse represents the verification status of a linked account
+type VerificationStatusResponse struct {
+	Provider        string `json:"provider" example:"github"`
+	AccountUsername string `json:"accountUsername" example:"johndoe"`
+	Verified        bool   `json:"verified" example:"true"`
+}
+
+// CreateVerification godoc
+// @Summary Start account verification
+// @Description Issues a challenge token for proving ownership of the linked GitHub or LeetCode account. Requesting a new challenge replaces any pending one.
+// @Tags Verification
+// @Accept json
+// @Produce json
+// @Param provider path string true "Account provider" Enums(github, leetcode)
+// @Success 201 {object} VerificationChallengeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/verifications/{provider} [post]
+func CreateVerification(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	provider := c.Param("provider")
+
+	var user db.User
+	if err := db.GetDB().Where("id = ?", userId).First(&user).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	accountUsername, verified, err := linkedAccount(&user, provider)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if verified {
+		c.JSON(http.StatusConflict, gin.H{"error": "Account is already verified"})
+		return
+	}
+
+	token, err := generateVerificationToken()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate verification token"})
+		return
+	}
+
+	verification := db.AccountVerification{
+		UserId:          user.Id,
+		Provider:        provider,
+		AccountUsername: accountUsername,
+		Token:           token,
+		ExpiresAt:       time.Now().Add(verificationTTL),
+	}
+
+	err = db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND provider = ?", user.Id, provider).
+			Delete(&db.AccountVerification{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&verification).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create verification"})
+		return
+	}
+
+	instructions := "Add the token to your GitHub bio or a public gist, then check verification"
+	if provider == db.VerificationProviderLeetCode {
+		instructions = "Add the token to your LeetCode profile summary, then check verification"
+	}
+
+	c.JSON(http.StatusCreated, VerificationChallengeResponse{
+		Provider:        provider,
+		AccountUsername: accountUsername,
+		Token:           token,
+		ExpiresAt:       verification.ExpiresAt,
+		Instructions:    instructions,
+	})
+}
+
+// CheckVerification godoc
+// @Summary Check account verification
+// @Description Looks for the pending challenge token on the linked GitHub or LeetCode profile and marks the account verified when it is found
+// @Tags Verification
+// @Accept json
+// @Produce json
+// @Param provider path string true "Account provider" Enums(github, leetcode)
+// @Success 200 {object} VerificationStatusResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/verifications/{provider}/check [post]
+func CheckVerification(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	provider := c.Param("provider")
+
+	var user db.User
+	if err := db.GetDB().Where("id = ?", userId).First(&user).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	accountUsername, verified, err := linkedAccount(&user, provider)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if verified {
+		c.JSON(http.StatusOK, VerificationStatusResponse{
+			Provider:        provider,
+			AccountUsername: accountUsername,
+			Verified:        true,
+		})
+		return
+	}
+
+	var verification db.AccountVerification
+	result := db.GetDB().Where("user_id = ? AND provider = ?", user.Id, provider).First(&verification)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No pending verification for this account"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch verification"})
+		return
+	}
+
+	if time.Now().After(verification.ExpiresAt) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Verification token has expired, request a new one"})
+		return
+	}
+	if verification.AccountUsername != accountUsername {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Linked account has changed, request a new verification token"})
+		return
+	}
+
+	var found bool
+	switch provider {
+	case db.VerificationProviderGitHub:
+		found, err = services.NewGitHubService().HasVerificationToken(c.Request.Context(), accountUsername, verification.Token)
+	case db.VerificationProviderLeetCode:
+		found, err = services.NewLeetCodeService().HasVerificationToken(c.Request.Context(), accountUsername, verification.Token)
+	}
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	if !found {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Verification token not found on the linked profile"})
+		return
+	}
+
+	err = db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).Update(verifiedColumn(provider), true).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&verification).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify account"})
+		return
+	}
+
+	c.JSON(http.StatusOK, VerificationStatusResponse{
+		Provider:        provider,
+		AccountUsername: accountUsername,
+		Verified:        true,
+	})
+}
+
+// linkedAccount returns the linked username and verification status for a
+// provider, or an error if the provider is unsupported or not linked
+func linkedAccount(user *db.User, provider string) (string, bool, error) {
+	switch provider {
+	case db.VerificationProviderGitHub:
+		if user.GitHubUsername == nil || *user.GitHubUsername == "" {
+			return "", false, errors.New("no GitHub account is linked")
+		}
+		return *user.GitHubUsername, user.GitHubVerified, nil
+	case db.VerificationProviderLeetCode:
+		if user.LeetCodeUsername == nil || *user.LeetCodeUsername == "" {
+			return "", false, errors.New("no LeetCode account is linked")
+		}
+		return *user.LeetCodeUsername, user.LeetCodeVerified, nil
+	default:
+		return "", false, errors.New("provider must be github or leetcode")
+	}
+}
+
+// verifiedColumn returns the users column holding a provider's verification status
+func verifiedColumn(provider string) string {
+	if provider == db.VerificationProviderLeetCode {
+		return "leet_code_verified"
+	}
+	return "git_hub_verified"
+}
+
+// generateVerificationToken returns a random token for placing on an
+// external profile
+func generateVerificationToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "devboard-verify-" + hex.EncodeToString(b), nil
+}
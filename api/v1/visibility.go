@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+	"github.com/ryanmello/devboard/db"
+	"gorm.io/gorm"
+)
+
+// canViewProfile reports whether the request's viewer may see the sections
+// of the user's profile. Public profiles are visible to everyone; private
+// ones only to the user and their followers.
+func canViewProfile(c *gin.Context, user *db.User) (bool, error) {
+	if user.Visibility != db.VisibilityPrivate {
+		return true, nil
+	}
+
+	viewerId, ok := c.Get("userId")
+	if !ok {
+		return false, nil
+	}
+	if viewerId.(string) == user.Id {
+		return true, nil
+	}
+
+	var count int64
+	err := db.GetDB().Model(&db.Follow{}).Where("follower_id = ? AND following_id = ?", viewerId, user.Id).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// findVisibleProfile loads the user with the given username like
+// findUserByUsername, additionally writing a 403 response if their profile
+// is private to the viewer
+func findVisibleProfile(c *gin.Context, username string) (*db.User, bool) {
+	user, ok := findUserByUsername(c, username)
+	if !ok {
+		return nil, false
+	}
+
+	visible, err := canViewProfile(c, user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch user"})
+		return nil, false
+	}
+	if !visible {
+		c.JSON(http.StatusForbidden, gin.H{"error": "This profile is private"})
+		return nil, false
+	}
+
+	return user, true
+}
+
+// visibleProfiles restricts a users query to the profiles the request's
+// viewer can see
+func visibleProfiles(c *gin.Context, query *gorm.DB) *gorm.DB {
+	viewerId, ok := c.Get("userId")
+	if !ok {
+		return query.Where("visibility <> ?", db.VisibilityPrivate)
+	}
+	return query.Where("visibility <> ? OR id = ? OR id IN (?)",
+		db.VisibilityPrivate, viewerId, followingIdsOf(viewerId.(string)))
+}
+
+// redactProfile strips a private profile down to what identifies the user,
+// which is all that is shown to anyone who does not follow them
+func redactProfile(user *db.User) {
+	*user = db.User{
+		Id:         user.Id,
+		Username:   user.Username,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		Image:      user.Image,
+		Headline:   user.Headline,
+		Role:       user.Role,
+		Visibility: user.Visibility,
+		Skills:     pq.StringArray{},
+		CreatedAt:  user.CreatedAt,
+		UpdatedAt:  user.UpdatedAt,
+		FollowsYou: user.FollowsYou,
+		YouFollow:  user.YouFollow,
+	}
+}
+
+// redactPrivateUsers redacts the private profiles in a list of users that
+// the request's viewer does not follow, checking follows in a single query
+func redactPrivateUsers(c *gin.Context, users []db.User) error {
+	viewerId, _ := c.Get("userId")
+	viewer, _ := viewerId.(string)
+
+	private := []string{}
+	for _, user := range users {
+		if user.Visibility == db.VisibilityPrivate && user.Id != viewer {
+			private = append(private, user.Id)
+		}
+	}
+	if len(private) == 0 {
+		return nil
+	}
+
+	var followed []string
+	if viewer != "" {
+		if err := db.GetDB().Model(&db.Follow{}).Where("follower_id = ? AND following_id IN ?", viewer, private).
+			Pluck("following_id", &followed).Error; err != nil {
+			return err
+		}
+	}
+	visible := make(map[string]bool, len(followed))
+	for _, id := range followed {
+		visible[id] = true
+	}
+
+	for i := range users {
+		if users[i].Visibility == db.VisibilityPrivate && users[i].Id != viewer && !visible[users[i].Id] {
+			redactProfile(&users[i])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/services"
+	"gorm.io/gorm"
+)
+
+// ConnectWakaTimeRequest represents the request body for linking a WakaTime account
+type ConnectWakaTimeRequest struct {
+	APIKey string `json:"apiKey" binding:"required" example:"waka_00000000-0000-0000-0000-000000000000"`
+}
+
+// ConnectedAccountResponse represents a linked third-party account
+type ConnectedAccountResponse struct {
+	Provider    string    `json:"provider" example:"wakatime"`
+	AccountName string    `json:"accountName" example:"johndoe"`
+	ConnectedAt time.Time `json:"connectedAt"`
+}
+
+// ConnectWakaTime godoc
+// @Summary Link WakaTime account
+// @Description Links a WakaTime account using its secret API key. The key is checked against WakaTime, then stored encrypted; linking again replaces the stored key.
+// @Tags WakaTime
+// @Accept json
+// @Produce json
+// @Param request body ConnectWakaTimeRequest true "WakaTime API key"
+// @Success 200 {object} ConnectedAccountResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/wakatime [put]
+func ConnectWakaTime(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	var req ConnectWakaTimeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	apiKey := strings.TrimSpace(req.APIKey)
+
+	accountName, err := services.NewWakaTimeService(apiKey).GetUsername(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCredentials) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid WakaTime API key"})
+			return
+		}
+		respondExternalError(c, err)
+		return
+	}
+
+	encrypted, err := services.EncryptSecret(apiKey)
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	var account db.ConnectedAccount
+	result := db.GetDB().Where("user_id = ? AND provider = ?", userId, db.ConnectedAccountWakaTime).First(&account)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch connected account"})
+		return
+	}
+
+	account.UserId = userId.(string)
+	account.Provider = db.ConnectedAccountWakaTime
+	account.AccountName = accountName
+	account.EncryptedToken = encrypted
+
+	if err := db.GetDB().Save(&account).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save connected account"})
+		return
+	}
+
+	c.JSON(http.StatusOK, ConnectedAccountResponse{
+		Provider:    account.Provider,
+		AccountName: account.AccountName,
+		ConnectedAt: account.UpdatedAt,
+	})
+}
+
+// DisconnectWakaTime godoc
+// @Summary Unlink WakaTime account
+// @Description Removes the linked WakaTime account and its stored API key
+// @Tags WakaTime
+// @Accept json
+// @Produce json
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/wakatime [delete]
+func DisconnectWakaTime(c *gin.Context) {
+	userId, exists := c.Get("userId")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	result := db.GetDB().Where("user_id = ? AND provider = ?", userId, db.ConnectedAccountWakaTime).
+		Delete(&db.ConnectedAccount{})
+	if result.Error != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to disconnect WakaTime account"})
+		return
+	}
+
+	if result.RowsAffected == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No WakaTime account is connected"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "WakaTime account disconnected"})
+}
+
+// GetWakaTimeData godoc
+// @Summary Get WakaTime coding time
+// @Description Returns coding time, top languages, editors and projects over the last seven days from the user's linked WakaTime account
+// @Tags External
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} services.WakaTimeStats
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 502 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Failure 504 {object} ErrorResponse
+// @Router /users/{username}/wakatime [get]
+func GetWakaTimeData(c *gin.Context) {
+	username := c.Param("username")
+
+	user, ok := findVisibleProfile(c, username)
+	if !ok {
+		return
+	}
+
+	var account db.ConnectedAccount
+	result := db.GetDB().Where("user_id = ? AND provider = ?", user.Id, db.ConnectedAccountWakaTime).First(&account)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User has not connected a WakaTime account"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch connected account"})
+		return
+	}
+
+	apiKey, err := services.DecryptSecret(account.EncryptedToken)
+	if err != nil {
+		if errors.Is(err, services.ErrNotConfigured) {
+			respondExternalError(c, err)
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read WakaTime credentials"})
+		return
+	}
+
+	stats, err := services.NewWakaTimeService(apiKey).GetStats(c.Request.Context())
+	if err != nil {
+		respondExternalError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
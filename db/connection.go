@@ -34,6 +34,21 @@ func AutoMigrate() error {
 		&Education{},
 		&Experience{},
 		&Follow{},
+		&FollowRequest{},
+		&AccountVerification{},
+		&ConnectedAccount{},
+		&Event{},
+		&Notification{},
+		&NotificationPreference{},
+		&Endorsement{},
+		&ProjectStar{},
+		&ProjectComment{},
+		&Block{},
+		&Mute{},
+		&Conversation{},
+		&ConversationMember{},
+		&Message{},
+		&Recommendation{},
 	)
 
 	if err != nil {
@@ -1,8 +1,27 @@
 package db
 
 import (
-	"github.com/lib/pq"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
+
+	"github.com/lib/pq"
+)
+
+// Profile visibility options. Private profiles only show their sections to
+// the user's followers, who must be approved through a follow request.
+const (
+	VisibilityPublic  = "public"
+	VisibilityPrivate = "private"
+)
+
+// Who may start a conversation with a user: anyone, only their followers,
+// or only users they follow back
+const (
+	AllowMessagesAnyone    = "anyone"
+	AllowMessagesFollowers = "followers"
+	AllowMessagesMutuals   = "mutuals"
 )
 
 type User struct {
@@ -16,8 +35,17 @@ type User struct {
 	Resume           *string        `json:"resume"`
 	Role             string         `gorm:"default:user" json:"role"`
 	GitHubUsername   *string        `json:"githubUsername"`
+	GitHubVerified   bool           `gorm:"default:false" json:"githubVerified"`
 	LeetCodeUsername *string        `json:"leetcodeUsername"`
+	LeetCodeVerified bool           `gorm:"default:false" json:"leetcodeVerified"`
 	LinkedInUsername *string        `json:"linkedinUsername"`
+	CodeforcesHandle *string        `json:"codeforcesHandle"`
+	GitLabUsername   *string        `json:"gitlabUsername"`
+	GitLabURL        *string        `json:"gitlabUrl"`
+	StackOverflowId  *string        `json:"stackoverflowId"`
+	TimeZone         *string        `json:"timeZone"`
+	Visibility       string         `gorm:"not null;default:public" json:"visibility"`
+	AllowMessages    string         `gorm:"not null;default:anyone" json:"allowMessages"`
 	Skills           pq.StringArray `gorm:"type:text[]" json:"skills" swaggertype:"array,string"`
 	CreatedAt        time.Time      `json:"createdAt"`
 	UpdatedAt        time.Time      `json:"updatedAt"`
@@ -25,6 +53,14 @@ type User struct {
 	Projects   []Project    `gorm:"foreignKey:UserId" json:"projects,omitempty"`
 	Education  []Education  `gorm:"foreignKey:UserId" json:"education,omitempty"`
 	Experience []Experience `gorm:"foreignKey:UserId" json:"experience,omitempty"`
+
+	// Filled in for profile views, not stored on the user
+	Endorsements    []SkillEndorsements `gorm:"-" json:"endorsements,omitempty"`
+	Recommendations []Recommendation    `gorm:"-" json:"recommendations,omitempty"`
+
+	// Filled in on follow lists for an authenticated viewer, relative to them
+	FollowsYou *bool `gorm:"-" json:"followsYou,omitempty"`
+	YouFollow  *bool `gorm:"-" json:"youFollow,omitempty"`
 }
 
 type Project struct {
@@ -36,8 +72,32 @@ type Project struct {
 	Description     *string   `json:"description"`
 	Image           *string   `json:"image"`
 	URL             *string   `json:"url"`
+	StarCount       int       `gorm:"not null;default:0" json:"starCount"`
 	CreatedAt       time.Time `json:"createdAt"`
 	UpdatedAt       time.Time `json:"updatedAt"`
+
+	Repository ProjectRepository `gorm:"embedded;embeddedPrefix:repo_" json:"repository"`
+}
+
+// Repository sync statuses for projects with a GitHub URL
+const (
+	RepoStatusOK         = "ok"
+	RepoStatusRenamed    = "renamed"
+	RepoStatusNotFound   = "not_found"
+	RepoStatusInvalidURL = "invalid_url"
+)
+
+// ProjectRepository holds metadata synced from a project's GitHub repository
+type ProjectRepository struct {
+	FullName   *string        `json:"fullName"`
+	Stars      *int           `json:"stars"`
+	Forks      *int           `json:"forks"`
+	PushedAt   *time.Time     `json:"pushedAt"`
+	License    *string        `json:"license"`
+	IsArchived bool           `gorm:"default:false" json:"isArchived"`
+	Topics     pq.StringArray `gorm:"type:text[]" json:"topics" swaggertype:"array,string"`
+	Status     *string        `json:"status"`
+	SyncedAt   *time.Time     `json:"syncedAt"`
 }
 
 type Education struct {
@@ -80,3 +140,279 @@ type Follow struct {
 	Follower    User      `gorm:"foreignKey:FollowerId" json:"follower,omitempty"`
 	Following   User      `gorm:"foreignKey:FollowingId" json:"following,omitempty"`
 }
+
+// FollowRequest is a pending request to follow a private profile. Approving
+// it turns it into a Follow.
+type FollowRequest struct {
+	Id          string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	RequesterId string    `gorm:"type:uuid;not null;uniqueIndex:idx_follow_request_requester_target" json:"requesterId"`
+	TargetId    string    `gorm:"type:uuid;not null;uniqueIndex:idx_follow_request_requester_target;index" json:"targetId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Requester   User      `gorm:"foreignKey:RequesterId" json:"requester,omitempty"`
+}
+
+// Providers that support account ownership verification
+const (
+	VerificationProviderGitHub   = "github"
+	VerificationProviderLeetCode = "leetcode"
+)
+
+type AccountVerification struct {
+	Id              string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	UserId          string    `gorm:"type:uuid;not null;uniqueIndex:idx_verification_user_provider" json:"userId"`
+	Provider        string    `gorm:"not null;uniqueIndex:idx_verification_user_provider" json:"provider"`
+	AccountUsername string    `gorm:"not null" json:"accountUsername"`
+	Token           string    `gorm:"not null" json:"token"`
+	ExpiresAt       time.Time `gorm:"not null" json:"expiresAt"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// Providers whose credentials can be stored as a connected account
+const (
+	ConnectedAccountWakaTime = "wakatime"
+	ConnectedAccountGitHub   = "github"
+)
+
+// ConnectedAccount holds the encrypted credentials for a third-party account
+// a user has linked
+type ConnectedAccount struct {
+	Id             string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	UserId         string    `gorm:"type:uuid;not null;uniqueIndex:idx_connected_account_user_provider" json:"userId"`
+	Provider       string    `gorm:"not null;uniqueIndex:idx_connected_account_user_provider" json:"provider"`
+	AccountName    string    `gorm:"not null" json:"accountName"`
+	EncryptedToken string    `gorm:"not null" json:"-"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+
+	// Set for OAuth providers that issue expiring tokens
+	EncryptedRefreshToken *string    `json:"-"`
+	ExpiresAt             *time.Time `json:"expiresAt"`
+	Scope                 *string    `json:"scope"`
+}
+
+// Event types recorded in the activity feed
+const (
+	EventProjectAdded      = "project_added"
+	EventNewRole           = "new_role"
+	EventSkillsUpdated     = "skills_updated"
+	EventFollowerMilestone = "follower_milestone"
+)
+
+// Event is something a user did that is shown in their followers' feeds.
+// Events are written once per actor and gathered per reader when the feed is
+// read, so a user with many followers costs a single insert.
+type Event struct {
+	Id        string       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	ActorId   string       `gorm:"type:uuid;not null;index:idx_event_actor_created,priority:1" json:"actorId"`
+	Type      string       `gorm:"not null" json:"type" example:"project_added"`
+	Payload   EventPayload `gorm:"type:jsonb" json:"payload" swaggertype:"object"`
+	CreatedAt time.Time    `gorm:"index:idx_event_actor_created,priority:2" json:"createdAt"`
+	Actor     User         `gorm:"foreignKey:ActorId" json:"actor,omitempty"`
+}
+
+// EventPayload holds the type-specific details of an event, stored as JSON
+type EventPayload map[string]interface{}
+
+// Value implements driver.Valuer
+func (p EventPayload) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return json.Marshal(p)
+}
+
+// Scan implements sql.Scanner
+func (p *EventPayload) Scan(value interface{}) error {
+	if value == nil {
+		*p = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported event payload type %T", value)
+	}
+
+	return json.Unmarshal(data, p)
+}
+
+// Notification types a user can receive
+const (
+	NotificationFollow         = "follow"
+	NotificationEndorsement    = "endorsement"
+	NotificationProjectStar    = "project_star"
+	NotificationProjectComment = "project_comment"
+	NotificationCommentReply   = "comment_reply"
+	NotificationFollowRequest  = "follow_request"
+	NotificationFollowAccepted = "follow_accepted"
+
+	NotificationRecommendationRequest   = "recommendation_request"
+	NotificationRecommendationSubmitted = "recommendation_submitted"
+)
+
+// NotificationTypes lists every notification type, in the order preferences
+// are shown
+var NotificationTypes = []string{
+	NotificationFollow,
+	NotificationEndorsement,
+	NotificationProjectStar,
+	NotificationProjectComment,
+	NotificationCommentReply,
+	NotificationFollowRequest,
+	NotificationFollowAccepted,
+	NotificationRecommendationRequest,
+	NotificationRecommendationSubmitted,
+}
+
+// Notification tells a user about something another user did involving them.
+// EntityId points at the record the notification is about, when there is one.
+type Notification struct {
+	Id        string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	UserId    string     `gorm:"type:uuid;not null;index:idx_notification_user_created,priority:1" json:"userId"`
+	ActorId   string     `gorm:"type:uuid;not null" json:"actorId"`
+	Type      string     `gorm:"not null" json:"type" example:"follow"`
+	EntityId  *string    `gorm:"type:uuid" json:"entityId"`
+	ReadAt    *time.Time `json:"readAt"`
+	CreatedAt time.Time  `gorm:"index:idx_notification_user_created,priority:2" json:"createdAt"`
+	Actor     User       `gorm:"foreignKey:ActorId" json:"actor,omitempty"`
+}
+
+// NotificationPreference records whether a user receives a notification
+// type. Types without a row are enabled.
+type NotificationPreference struct {
+	UserId    string    `gorm:"type:uuid;primaryKey" json:"-"`
+	Type      string    `gorm:"primaryKey" json:"type" example:"follow"`
+	Enabled   bool      `gorm:"not null" json:"enabled"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Endorsement is one user vouching for a skill another user lists
+type Endorsement struct {
+	Id         string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	UserId     string    `gorm:"type:uuid;not null;uniqueIndex:idx_endorsement_user_endorser_skill,priority:1" json:"userId"`
+	EndorserId string    `gorm:"type:uuid;not null;uniqueIndex:idx_endorsement_user_endorser_skill,priority:2" json:"endorserId"`
+	Skill      string    `gorm:"not null;uniqueIndex:idx_endorsement_user_endorser_skill,priority:3" json:"skill" example:"Go"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Endorser   User      `gorm:"foreignKey:EndorserId" json:"endorser,omitempty"`
+}
+
+// SkillEndorsements summarises the endorsements of one of a user's skills
+type SkillEndorsements struct {
+	Skill        string `json:"skill" example:"Go"`
+	Count        int64  `json:"count" example:"12"`
+	TopEndorsers []User `json:"topEndorsers"`
+}
+
+// ProjectStar is a user starring a project. Project.StarCount is kept in step
+// with these rows so projects can be sorted by stars cheaply.
+type ProjectStar struct {
+	ProjectId string    `gorm:"type:uuid;primaryKey" json:"projectId"`
+	UserId    string    `gorm:"type:uuid;primaryKey;index" json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+	User      User      `gorm:"foreignKey:UserId" json:"user,omitempty"`
+}
+
+// ProjectComment is a comment on a project. Replies point at the comment
+// they answer through ParentId and at the top-level comment of their thread
+// through RootId. A comment deleted while it has replies keeps its place in
+// the thread with its body cleared and DeletedAt set.
+type ProjectComment struct {
+	Id        string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	ProjectId string     `gorm:"type:uuid;not null;index:idx_project_comment_project_root,priority:1" json:"projectId"`
+	UserId    string     `gorm:"type:uuid;not null" json:"userId"`
+	ParentId  *string    `gorm:"type:uuid" json:"parentId"`
+	RootId    *string    `gorm:"type:uuid;index:idx_project_comment_project_root,priority:2" json:"rootId"`
+	Body      string     `gorm:"not null" json:"body"`
+	DeletedAt *time.Time `json:"deletedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	User      *User      `gorm:"foreignKey:UserId" json:"user,omitempty"`
+
+	Replies []ProjectComment `gorm:"-" json:"replies,omitempty"`
+}
+
+// Block is a user blocking another. The two users are hidden from each other
+// and cannot follow or interact with each other, whichever of them blocked.
+type Block struct {
+	BlockerId string    `gorm:"type:uuid;primaryKey" json:"blockerId"`
+	BlockedId string    `gorm:"type:uuid;primaryKey;index" json:"blockedId"`
+	CreatedAt time.Time `json:"createdAt"`
+	Blocked   User      `gorm:"foreignKey:BlockedId" json:"blocked,omitempty"`
+}
+
+// Mute is a user muting another, which keeps the muted user out of the
+// muter's feed and notifications without affecting anything else
+type Mute struct {
+	MuterId   string    `gorm:"type:uuid;primaryKey" json:"muterId"`
+	MutedId   string    `gorm:"type:uuid;primaryKey" json:"mutedId"`
+	CreatedAt time.Time `json:"createdAt"`
+	Muted     User      `gorm:"foreignKey:MutedId" json:"muted,omitempty"`
+}
+
+// Conversation is a private conversation between two users. PairKey joins
+// their ids in sorted order so each pair has at most one conversation.
+type Conversation struct {
+	Id            string               `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	PairKey       string               `gorm:"uniqueIndex;not null" json:"-"`
+	StartedBy     string               `gorm:"type:uuid;not null;index:idx_conversation_started,priority:1" json:"startedBy"`
+	LastMessageAt time.Time            `json:"lastMessageAt"`
+	CreatedAt     time.Time            `gorm:"index:idx_conversation_started,priority:2" json:"createdAt"`
+	Members       []ConversationMember `gorm:"foreignKey:ConversationId" json:"members,omitempty"`
+
+	// Filled in for conversation lists, not stored on the conversation
+	LastMessage *Message `gorm:"-" json:"lastMessage,omitempty"`
+	UnreadCount int64    `gorm:"-" json:"unreadCount"`
+}
+
+// ConversationMember is a user taking part in a conversation. LastReadAt is
+// their read receipt: every message sent up to then has been read.
+type ConversationMember struct {
+	ConversationId string     `gorm:"type:uuid;primaryKey" json:"conversationId"`
+	UserId         string     `gorm:"type:uuid;primaryKey;index" json:"userId"`
+	LastReadAt     *time.Time `json:"lastReadAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	User           User       `gorm:"foreignKey:UserId" json:"user,omitempty"`
+}
+
+// Message is a message sent in a conversation
+type Message struct {
+	Id             string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	ConversationId string    `gorm:"type:uuid;not null;index:idx_message_conversation_created,priority:1" json:"conversationId"`
+	SenderId       string    `gorm:"type:uuid;not null" json:"senderId"`
+	Body           string    `gorm:"not null" json:"body"`
+	CreatedAt      time.Time `gorm:"index:idx_message_conversation_created,priority:2" json:"createdAt"`
+}
+
+// Recommendation statuses. A recommendation is requested by the user it is
+// about, submitted once the recommender writes it, and shown on the profile
+// once the user approves it. Approved recommendations can be hidden again.
+const (
+	RecommendationRequested = "requested"
+	RecommendationSubmitted = "submitted"
+	RecommendationApproved  = "approved"
+	RecommendationHidden    = "hidden"
+)
+
+// Recommendation is a written recommendation of a user by someone they
+// worked with, optionally about one of the user's experience entries
+type Recommendation struct {
+	Id            string      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	UserId        string      `gorm:"type:uuid;not null;index:idx_recommendation_user_status,priority:1" json:"userId"`
+	RecommenderId string      `gorm:"type:uuid;not null;index" json:"recommenderId"`
+	ExperienceId  *string     `gorm:"type:uuid" json:"experienceId"`
+	Status        string      `gorm:"not null;index:idx_recommendation_user_status,priority:2" json:"status"`
+	Message       *string     `json:"message"`
+	Body          *string     `json:"body"`
+	SubmittedAt   *time.Time  `json:"submittedAt"`
+	ApprovedAt    *time.Time  `json:"approvedAt"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	UpdatedAt     time.Time   `json:"updatedAt"`
+	User          *User       `gorm:"foreignKey:UserId" json:"user,omitempty"`
+	Recommender   User        `gorm:"foreignKey:RecommenderId" json:"recommender,omitempty"`
+	Experience    *Experience `gorm:"foreignKey:ExperienceId" json:"experience,omitempty"`
+}
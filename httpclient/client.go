@@ -0,0 +1,234 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout          = 10 * time.Second
+	defaultMaxAttempts      = 3
+	defaultBaseBackoff      = 200 * time.Millisecond
+	defaultMaxBackoff       = 2 * time.Second
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+)
+
+// Default is the shared client used for all outbound requests
+var Default = New()
+
+// Client is an outbound HTTP client that applies a deadline to every call,
+// retries transient failures with jittered exponential backoff and trips a
+// circuit breaker per upstream after repeated failures
+type Client struct {
+	httpClient       *http.Client
+	timeout          time.Duration
+	maxAttempts      int
+	baseBackoff      time.Duration
+	maxBackoff       time.Duration
+	failureThreshold int
+	openDuration     time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+// New creates a client with the default deadline, retry and circuit
+// breaker settings
+func New() *Client {
+	return &Client{
+		httpClient:       &http.Client{},
+		timeout:          defaultTimeout,
+		maxAttempts:      defaultMaxAttempts,
+		baseBackoff:      defaultBaseBackoff,
+		maxBackoff:       defaultMaxBackoff,
+		failureThreshold: defaultFailureThreshold,
+		openDuration:     defaultOpenDuration,
+		breakers:         make(map[string]*breaker),
+	}
+}
+
+// Do sends req to the named upstream. The call is bounded by the client
+// timeout and by any deadline already on ctx. Network errors, 429 and 5xx
+// responses are retried; once retries are exhausted they are returned as an
+// *UpstreamError instead of a response. Other responses are returned as-is
+// for the caller to interpret, and their body must be closed.
+func (c *Client) Do(ctx context.Context, upstream string, req *http.Request) (*http.Response, error) {
+	b := c.breaker(upstream)
+	if !b.allow(time.Now()) {
+		return nil, &UpstreamError{Upstream: upstream, Err: ErrCircuitOpen}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+
+	var lastErr error
+	for attempt := 0; attempt < c.maxAttempts; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, c.backoff(attempt, lastErr)); err != nil {
+				break
+			}
+		}
+
+		attemptReq, err := rewind(ctx, req)
+		if err != nil {
+			cancel()
+			return nil, &UpstreamError{Upstream: upstream, Err: err}
+		}
+
+		resp, err := c.httpClient.Do(attemptReq)
+		if err != nil {
+			// The caller going away says nothing about the upstream's health
+			if errors.Is(ctx.Err(), context.Canceled) {
+				b.abandon()
+				cancel()
+				return nil, &UpstreamError{Upstream: upstream, Err: err}
+			}
+
+			lastErr = &UpstreamError{Upstream: upstream, Err: classify(ctx, err)}
+			b.record(false, time.Now())
+			if ctx.Err() != nil || !retryable(req) || errors.Is(err, ErrDisallowedAddress) {
+				break
+			}
+			continue
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			lastErr = statusError(upstream, resp)
+			resp.Body.Close()
+			b.record(false, time.Now())
+			if !retryable(req) {
+				break
+			}
+			continue
+		}
+
+		b.record(true, time.Now())
+
+		// The deadline must outlive Do so the caller can read the body
+		resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+		return resp, nil
+	}
+
+	cancel()
+
+	if lastErr == nil || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		lastErr = &UpstreamError{Upstream: upstream, Err: ErrTimeout}
+	}
+	return nil, lastErr
+}
+
+// breaker returns the circuit breaker for an upstream, creating it on first use
+func (c *Client) breaker(upstream string) *breaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.breakers[upstream]
+	if !ok {
+		b = &breaker{threshold: c.failureThreshold, openDuration: c.openDuration}
+		c.breakers[upstream] = b
+	}
+	return b
+}
+
+// backoff returns how long to wait before the given retry attempt. It uses
+// full jitter over an exponentially growing window, and honours a
+// Retry-After hint from the previous response when one was sent.
+func (c *Client) backoff(attempt int, lastErr error) time.Duration {
+	var upstreamErr *UpstreamError
+	if errors.As(lastErr, &upstreamErr) && upstreamErr.RetryAfter > 0 {
+		if upstreamErr.RetryAfter > c.maxBackoff {
+			return c.maxBackoff
+		}
+		return upstreamErr.RetryAfter
+	}
+
+	window := c.baseBackoff << (attempt - 1)
+	if window <= 0 || window > c.maxBackoff {
+		window = c.maxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(window) + 1))
+}
+
+// rewind prepares a copy of req for another attempt, bound to ctx and with a
+// fresh body
+func rewind(ctx context.Context, req *http.Request) (*http.Request, error) {
+	attemptReq := req.Clone(ctx)
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attemptReq.Body = body
+	}
+	return attemptReq, nil
+}
+
+// retryable reports whether a request can safely be sent again. Requests
+// whose body cannot be replayed are only sent once.
+func retryable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// classify maps a transport error onto the typed upstream errors
+func classify(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ErrTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrTimeout
+	}
+
+	return errors.Join(ErrUnavailable, err)
+}
+
+// statusError builds the error for a response that exhausted its retries
+func statusError(upstream string, resp *http.Response) *UpstreamError {
+	err := &UpstreamError{
+		Upstream:   upstream,
+		StatusCode: resp.StatusCode,
+		Err:        ErrBadGateway,
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		err.Err = ErrRateLimited
+	}
+	if seconds, parseErr := strconv.Atoi(resp.Header.Get("Retry-After")); parseErr == nil && seconds > 0 {
+		err.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	return err
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelOnClose releases a call's deadline once its response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,375 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client with short backoffs so retries run quickly
+func newTestClient() *Client {
+	c := New()
+	c.baseBackoff = time.Millisecond
+	c.maxBackoff = time.Millisecond
+	return c
+}
+
+// scriptedServer responds to each call with the next status in statuses,
+// repeating the last one once they run out
+func scriptedServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1)) - 1
+		if n >= len(statuses) {
+			n = len(statuses) - 1
+		}
+		if statuses[n] == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "1")
+		}
+		w.WriteHeader(statuses[n])
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestDoRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		noReplay   bool
+		wantStatus int
+		wantErr    error
+		wantCalls  int32
+	}{
+		{
+			name:       "success",
+			statuses:   []int{http.StatusOK},
+			wantStatus: http.StatusOK,
+			wantCalls:  1,
+		},
+		{
+			name:       "server error then success",
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+		},
+		{
+			name:       "rate limited then success",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+		},
+		{
+			name:      "server errors exhaust retries",
+			statuses:  []int{http.StatusBadGateway},
+			wantErr:   ErrBadGateway,
+			wantCalls: 3,
+		},
+		{
+			name:      "rate limits exhaust retries",
+			statuses:  []int{http.StatusTooManyRequests},
+			wantErr:   ErrRateLimited,
+			wantCalls: 3,
+		},
+		{
+			name:       "client errors are not retried",
+			statuses:   []int{http.StatusNotFound},
+			wantStatus: http.StatusNotFound,
+			wantCalls:  1,
+		},
+		{
+			name:      "bodies that cannot be replayed are sent once",
+			statuses:  []int{http.StatusInternalServerError},
+			noReplay:  true,
+			wantErr:   ErrBadGateway,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := scriptedServer(t, tt.statuses...)
+
+			req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.noReplay {
+				req, err = http.NewRequest(http.MethodPost, server.URL, strings.NewReader("{}"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				req.GetBody = nil
+			}
+
+			resp, err := newTestClient().Do(context.Background(), "test", req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Do() error = %v, want %v", err, tt.wantErr)
+				}
+				var upstreamErr *UpstreamError
+				if !errors.As(err, &upstreamErr) || upstreamErr.StatusCode != tt.statuses[len(tt.statuses)-1] {
+					t.Errorf("Do() error = %#v, want an UpstreamError with the last status", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Do() error = %v", err)
+				}
+				resp.Body.Close()
+				if resp.StatusCode != tt.wantStatus {
+					t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+				}
+			}
+
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("upstream called %d times, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestDoCircuitBreaker(t *testing.T) {
+	server, calls := scriptedServer(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK)
+
+	c := newTestClient()
+	c.maxAttempts = 1
+	c.failureThreshold = 2
+	c.openDuration = 50 * time.Millisecond
+
+	do := func() error {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := c.Do(context.Background(), "test", req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := do(); !errors.Is(err, ErrBadGateway) {
+			t.Fatalf("call %d error = %v, want %v", i+1, err, ErrBadGateway)
+		}
+	}
+
+	if err := do(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("error while open = %v, want %v", err, ErrCircuitOpen)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("upstream called %d times while open, want 2", got)
+	}
+
+	time.Sleep(c.openDuration)
+
+	if err := do(); err != nil {
+		t.Fatalf("trial call error = %v", err)
+	}
+	if err := do(); err != nil {
+		t.Fatalf("error after closing = %v", err)
+	}
+	if got := calls.Load(); got != 4 {
+		t.Errorf("upstream called %d times, want 4", got)
+	}
+}
+
+func TestBreaker(t *testing.T) {
+	start := time.Now()
+	afterOpen := start.Add(time.Minute)
+
+	tests := []struct {
+		name  string
+		steps func(b *breaker) bool
+		want  bool
+	}{
+		{
+			name:  "closed allows calls",
+			steps: func(b *breaker) bool { return b.allow(start) },
+			want:  true,
+		},
+		{
+			name: "failures below the threshold stay closed",
+			steps: func(b *breaker) bool {
+				b.record(false, start)
+				return b.allow(start)
+			},
+			want: true,
+		},
+		{
+			name: "success resets the failure count",
+			steps: func(b *breaker) bool {
+				b.record(false, start)
+				b.record(true, start)
+				b.record(false, start)
+				return b.allow(start)
+			},
+			want: true,
+		},
+		{
+			name: "threshold failures open the circuit",
+			steps: func(b *breaker) bool {
+				b.record(false, start)
+				b.record(false, start)
+				return b.allow(start)
+			},
+			want: false,
+		},
+		{
+			name: "open circuit half-opens after the open duration",
+			steps: func(b *breaker) bool {
+				b.record(false, start)
+				b.record(false, start)
+				return b.allow(afterOpen)
+			},
+			want: true,
+		},
+		{
+			name: "half-open circuit allows one trial at a time",
+			steps: func(b *breaker) bool {
+				b.record(false, start)
+				b.record(false, start)
+				b.allow(afterOpen)
+				return b.allow(afterOpen)
+			},
+			want: false,
+		},
+		{
+			name: "abandoned trial lets another through",
+			steps: func(b *breaker) bool {
+				b.record(false, start)
+				b.record(false, start)
+				b.allow(afterOpen)
+				b.abandon()
+				return b.allow(afterOpen)
+			},
+			want: true,
+		},
+		{
+			name: "failed trial reopens the circuit",
+			steps: func(b *breaker) bool {
+				b.record(false, start)
+				b.record(false, start)
+				b.allow(afterOpen)
+				b.record(false, afterOpen)
+				return b.allow(afterOpen.Add(time.Second))
+			},
+			want: false,
+		},
+		{
+			name: "successful trial closes the circuit",
+			steps: func(b *breaker) bool {
+				b.record(false, start)
+				b.record(false, start)
+				b.allow(afterOpen)
+				b.record(true, afterOpen)
+				b.record(false, afterOpen)
+				return b.allow(afterOpen)
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &breaker{threshold: 2, openDuration: time.Minute}
+			if got := tt.steps(b); got != tt.want {
+				t.Errorf("allow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient()
+	c.timeout = 50 * time.Millisecond
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := time.Now()
+	_, err = c.Do(context.Background(), "test", req)
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("Do() error = %v, want %v", err, ErrTimeout)
+	}
+	if elapsed := time.Since(started); elapsed > 500*time.Millisecond {
+		t.Errorf("Do() took %v, want it bounded by the timeout", elapsed)
+	}
+}
+
+func TestPublicRejectsPrivateAddresses(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	c := NewPublic()
+	c.baseBackoff = time.Millisecond
+	c.maxBackoff = time.Millisecond
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.Do(context.Background(), "test", req)
+	if !errors.Is(err, ErrDisallowedAddress) {
+		t.Fatalf("Do() error = %v, want %v", err, ErrDisallowedAddress)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("upstream called %d times, want 0", got)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"8.8.8.8", true},
+		{"140.82.112.3", true},
+		{"2606:4700:4700::1111", true},
+		{"0.0.0.0", false},
+		{"10.1.2.3", false},
+		{"100.64.0.1", false},
+		{"127.0.0.1", false},
+		{"169.254.169.254", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"198.18.0.1", false},
+		{"224.0.0.1", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"64:ff9b::a9fe:a9fe", false},
+		{"fc00::1", false},
+		{"fe80::1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := IsPublicIP(net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("IsPublicIP(%s) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrCircuitOpen is returned without contacting the upstream while its
+	// circuit breaker is open
+	ErrCircuitOpen = errors.New("upstream circuit open")
+
+	// ErrRateLimited is returned when the upstream kept responding with 429
+	ErrRateLimited = errors.New("upstream rate limited")
+
+	// ErrTimeout is returned when the call's deadline passed before the
+	// upstream responded
+	ErrTimeout = errors.New("upstream timed out")
+
+	// ErrUnavailable is returned when the upstream could not be reached
+	ErrUnavailable = errors.New("upstream unreachable")
+
+	// ErrBadGateway is returned when the upstream responded with a server
+	// error or a response that could not be used
+	ErrBadGateway = errors.New("upstream returned an invalid response")
+
+	// ErrDisallowedAddress is returned when a client restricted to public
+	// addresses is asked to connect to a loopback, private or link-local one
+	ErrDisallowedAddress = errors.New("upstream address is not public")
+)
+
+// UpstreamError describes a failed call to an upstream service. Err is one of
+// the sentinel errors above, optionally joined with the underlying cause.
+type UpstreamError struct {
+	Upstream   string
+	StatusCode int
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *UpstreamError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("%s: %v (status %d)", e.Upstream, e.Err, e.StatusCode)
+	}
+	return fmt.Sprintf("%s: %v", e.Upstream, e.Err)
+}
+
+func (e *UpstreamError) Unwrap() error {
+	return e.Err
+}
+
+// BadResponse returns the error for an upstream response the caller could not
+// use, such as an unexpected status code or a body that failed to decode
+func BadResponse(upstream string, statusCode int, cause error) error {
+	err := ErrBadGateway
+	if cause != nil {
+		err = errors.Join(ErrBadGateway, cause)
+	}
+	return &UpstreamError{Upstream: upstream, StatusCode: statusCode, Err: err}
+}
@@ -1,15 +1,18 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/ryanmello/devboard/api"
 	"github.com/ryanmello/devboard/config"
 	"github.com/ryanmello/devboard/db"
 	"github.com/ryanmello/devboard/middleware"
+	"github.com/ryanmello/devboard/services"
 
 	"github.com/ryanmello/devboard/docs" // Swagger docs
 
@@ -57,8 +60,11 @@ func main() {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
+	// Keep project repository metadata fresh in the background
+	services.StartProjectSync(15*time.Minute, 6*time.Hour)
+
 	// Fetch Supabase JWKS public key for JWT verification
-	publicKey, err := middleware.FetchJWKS(cfg.SupabaseURL)
+	publicKey, err := middleware.FetchJWKS(context.Background(), cfg.SupabaseURL)
 	if err != nil {
 		log.Fatalf("Failed to fetch Supabase JWKS: %v", err)
 	}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"encoding/base64"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/ryanmello/devboard/httpclient"
 )
 
 type jwksResponse struct {
@@ -26,13 +28,22 @@ type jwk struct {
 }
 
 // FetchJWKS fetches the ECDSA public key from the Supabase JWKS endpoint.
-func FetchJWKS(supabaseURL string) (*ecdsa.PublicKey, error) {
-	resp, err := http.Get(supabaseURL + "/auth/v1/.well-known/jwks.json")
+func FetchJWKS(ctx context.Context, supabaseURL string) (*ecdsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", supabaseURL+"/auth/v1/.well-known/jwks.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	resp, err := httpclient.Default.Do(ctx, "Supabase", req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned status %d", resp.StatusCode)
+	}
+
 	var jwksResp jwksResponse
 	if err := json.NewDecoder(resp.Body).Decode(&jwksResp); err != nil {
 		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
@@ -70,12 +81,7 @@ func AuthMiddleware(publicKey *ecdsa.PublicKey) gin.HandlerFunc {
 
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return publicKey, nil
-		})
+		token, err := jwt.Parse(tokenString, keyFunc(publicKey))
 
 		if err != nil || !token.Valid {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
@@ -98,3 +104,35 @@ func AuthMiddleware(publicKey *ecdsa.PublicKey) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// OptionalAuth sets userId like AuthMiddleware when the request carries a
+// valid token, and otherwise lets the request through anonymously so public
+// routes can tailor their responses to a signed-in viewer.
+func OptionalAuth(publicKey *ecdsa.PublicKey) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader != "" {
+			tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+			token, err := jwt.Parse(tokenString, keyFunc(publicKey))
+			if err == nil && token.Valid {
+				if claims, ok := token.Claims.(jwt.MapClaims); ok {
+					if userId, ok := claims["sub"].(string); ok {
+						c.Set("userId", userId)
+					}
+				}
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// keyFunc accepts only ECDSA-signed tokens, verified with publicKey
+func keyFunc(publicKey *ecdsa.PublicKey) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return publicKey, nil
+	}
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// ActivityStats summarises a user's daily coding activity
+type ActivityStats struct {
+	TimeZone           string   `json:"timeZone" example:"America/New_York"`
+	Sources            []string `json:"sources" example:"github,leetcode"`
+	UnavailableSources []string `json:"unavailableSources"`
+	CurrentStreak      int      `json:"currentStreak" example:"12"`
+	LongestStreak      int      `json:"longestStreak" example:"45"`
+	ActiveDays         int      `json:"activeDays" example:"210"`
+	TotalActivity      int      `json:"totalActivity" example:"1432"`
+	BusiestWeekday     *string  `json:"busiestWeekday" example:"Tuesday"`
+	Average7Days       float64  `json:"average7Days" example:"4.29"`
+	Average30Days      float64  `json:"average30Days" example:"3.87"`
+}
+
+// ComputeActivityStats derives streaks, active days, the busiest weekday and
+// rolling averages from per-day activity counts keyed by YYYY-MM-DD. Days are
+// taken as calendar dates in the time zone of now, and only the trailing year
+// up to and including today is considered. A current streak survives until
+// the end of the day after the last active day, so it is not reset before
+// the user has had a chance to be active today.
+func ComputeActivityStats(counts map[string]int, now time.Time) *ActivityStats {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	start := today.AddDate(-1, 0, 1)
+
+	stats := &ActivityStats{
+		TimeZone:           now.Location().String(),
+		Sources:            []string{},
+		UnavailableSources: []string{},
+	}
+
+	var weekdayTotals [7]int
+	var sum7, sum30, streak int
+	for day := start; !day.After(today); day = day.AddDate(0, 0, 1) {
+		count := counts[day.Format(calendarDateFormat)]
+		daysAgo := int(today.Sub(day).Hours() / 24)
+
+		if daysAgo < 7 {
+			sum7 += count
+		}
+		if daysAgo < 30 {
+			sum30 += count
+		}
+
+		if count == 0 {
+			streak = 0
+			continue
+		}
+
+		streak++
+		if streak > stats.LongestStreak {
+			stats.LongestStreak = streak
+		}
+		stats.ActiveDays++
+		stats.TotalActivity += count
+		weekdayTotals[day.Weekday()] += count
+	}
+
+	// The loop ends on today, so streak is the run ending today; if today has
+	// no activity yet, fall back to the run ending yesterday
+	stats.CurrentStreak = streak
+	if streak == 0 {
+		for day := today.AddDate(0, 0, -1); !day.Before(start); day = day.AddDate(0, 0, -1) {
+			if counts[day.Format(calendarDateFormat)] == 0 {
+				break
+			}
+			stats.CurrentStreak++
+		}
+	}
+
+	busiest := -1
+	for weekday, total := range weekdayTotals {
+		if total > 0 && (busiest < 0 || total > weekdayTotals[busiest]) {
+			busiest = weekday
+		}
+	}
+	if busiest >= 0 {
+		name := time.Weekday(busiest).String()
+		stats.BusiestWeekday = &name
+	}
+
+	stats.Average7Days = roundTo2(float64(sum7) / 7)
+	stats.Average30Days = roundTo2(float64(sum30) / 30)
+
+	return stats
+}
+
+// AddContributionCounts adds the days of a GitHub-style contribution calendar
+// to counts
+func AddContributionCounts(counts map[string]int, data *GitHubContributionData) {
+	for _, week := range data.Weeks {
+		for _, day := range week.ContributionDays {
+			counts[day.Date] += day.ContributionCount
+		}
+	}
+}
+
+// AddSubmissionCalendar adds a LeetCode submission calendar to counts. The
+// calendar is keyed by the Unix timestamp of each UTC day, so days are kept
+// as UTC dates rather than shifted into another time zone.
+func AddSubmissionCalendar(counts map[string]int, calendar map[string]int) {
+	for timestamp, count := range calendar {
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		counts[time.Unix(seconds, 0).UTC().Format(calendarDateFormat)] += count
+	}
+}
+
+// roundTo2 rounds a value to two decimal places
+func roundTo2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
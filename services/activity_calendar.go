@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ryanmello/devboard/db"
+)
+
+// Activity sources that can be merged into an activity calendar
+const (
+	ActivitySourceGitHub     = "github"
+	ActivitySourceGitLab     = "gitlab"
+	ActivitySourceLeetCode   = "leetcode"
+	ActivitySourceCodeforces = "codeforces"
+)
+
+// ActivitySources lists every activity source in display order
+var ActivitySources = []string{
+	ActivitySourceGitHub,
+	ActivitySourceGitLab,
+	ActivitySourceLeetCode,
+	ActivitySourceCodeforces,
+}
+
+// ActivityCalendar is a trailing-year activity heatmap merged from several
+// sources, arranged in Sunday-first weeks like a GitHub contribution calendar
+type ActivityCalendar struct {
+	TimeZone           string         `json:"timeZone" example:"America/New_York"`
+	Sources            []string       `json:"sources" example:"github,leetcode"`
+	UnavailableSources []string       `json:"unavailableSources"`
+	TotalActivity      int            `json:"totalActivity" example:"1432"`
+	TotalsBySource     map[string]int `json:"totalsBySource"`
+	Weeks              []ActivityWeek `json:"weeks"`
+}
+
+// ActivityWeek represents a week of activity
+type ActivityWeek struct {
+	Days []ActivityDay `json:"days"`
+}
+
+// ActivityDay is the activity on a single day, with the count contributed by
+// each source
+type ActivityDay struct {
+	Date    string         `json:"date" example:"2025-01-15"`
+	Count   int            `json:"count" example:"7"`
+	Sources map[string]int `json:"sources"`
+}
+
+// Counts returns the merged activity counts keyed by YYYY-MM-DD
+func (a *ActivityCalendar) Counts() map[string]int {
+	counts := make(map[string]int)
+	for _, week := range a.Weeks {
+		for _, day := range week.Days {
+			counts[day.Date] = day.Count
+		}
+	}
+	return counts
+}
+
+// LinkedActivitySources returns the activity sources a user has linked
+func LinkedActivitySources(user *db.User) []string {
+	var sources []string
+	if user.GitHubUsername != nil && *user.GitHubUsername != "" {
+		sources = append(sources, ActivitySourceGitHub)
+	}
+	if user.GitLabUsername != nil && *user.GitLabUsername != "" {
+		sources = append(sources, ActivitySourceGitLab)
+	}
+	if user.LeetCodeUsername != nil && *user.LeetCodeUsername != "" {
+		sources = append(sources, ActivitySourceLeetCode)
+	}
+	if user.CodeforcesHandle != nil && *user.CodeforcesHandle != "" {
+		sources = append(sources, ActivitySourceCodeforces)
+	}
+	return sources
+}
+
+// GetActivityCalendar fetches the given sources for a user concurrently and
+// merges them into one calendar ending today in loc. Sources the user has not
+// linked are skipped. A source that fails is listed as unavailable rather
+// than failing the whole calendar; an error is only returned when every
+// source failed.
+func GetActivityCalendar(ctx context.Context, user *db.User, sources []string, loc *time.Location) (*ActivityCalendar, error) {
+	linked := make(map[string]bool)
+	for _, source := range LinkedActivitySources(user) {
+		linked[source] = true
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		bySource = make(map[string]map[string]int)
+		failed   = make(map[string]error)
+	)
+
+	for _, source := range sources {
+		if !linked[source] {
+			continue
+		}
+
+		wg.Add(1)
+		go func(source string) {
+			defer wg.Done()
+
+			counts, err := fetchActivity(ctx, user, source, loc)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[source] = err
+				return
+			}
+			bySource[source] = counts
+		}(source)
+	}
+	wg.Wait()
+
+	calendar := BuildActivityCalendar(bySource, time.Now().In(loc))
+	for _, source := range ActivitySources {
+		if _, ok := failed[source]; ok {
+			calendar.UnavailableSources = append(calendar.UnavailableSources, source)
+		}
+	}
+
+	if len(bySource) == 0 && len(failed) > 0 {
+		return nil, failed[calendar.UnavailableSources[0]]
+	}
+
+	return calendar, nil
+}
+
+// fetchActivity returns a single source's activity counts keyed by
+// YYYY-MM-DD. GitHub, GitLab and LeetCode only report calendar days, so
+// those are used as-is; Codeforces reports exact times, which are bucketed
+// into days in loc.
+func fetchActivity(ctx context.Context, user *db.User, source string, loc *time.Location) (map[string]int, error) {
+	counts := make(map[string]int)
+
+	switch source {
+	case ActivitySourceGitHub:
+		data, err := GitHubServiceForUser(ctx, user.Id).GetContributions(ctx, *user.GitHubUsername)
+		if err != nil {
+			return nil, err
+		}
+		AddContributionCounts(counts, data)
+	case ActivitySourceGitLab:
+		data, err := NewGitLabService(StringValue(user.GitLabURL)).GetContributions(ctx, *user.GitLabUsername)
+		if err != nil {
+			return nil, err
+		}
+		AddContributionCounts(counts, data)
+	case ActivitySourceLeetCode:
+		data, err := NewLeetCodeService().GetStats(ctx, *user.LeetCodeUsername)
+		if err != nil {
+			return nil, err
+		}
+		AddSubmissionCalendar(counts, data.SubmissionCalendar)
+	case ActivitySourceCodeforces:
+		times, err := NewCodeforcesService().GetSubmissionTimes(ctx, *user.CodeforcesHandle)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range times {
+			counts[time.Unix(t, 0).In(loc).Format(calendarDateFormat)]++
+		}
+	}
+
+	return counts, nil
+}
+
+// BuildActivityCalendar merges per-source activity counts keyed by
+// YYYY-MM-DD into a calendar covering the trailing year up to and including
+// today. Weeks start on Sunday, as in BuildContributionCalendar.
+func BuildActivityCalendar(bySource map[string]map[string]int, today time.Time) *ActivityCalendar {
+	end := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(-1, 0, 1)
+	start = start.AddDate(0, 0, -int(start.Weekday()))
+
+	calendar := &ActivityCalendar{
+		TimeZone:           today.Location().String(),
+		Sources:            []string{},
+		UnavailableSources: []string{},
+		TotalsBySource:     make(map[string]int),
+		Weeks:              []ActivityWeek{},
+	}
+
+	for _, source := range ActivitySources {
+		if _, ok := bySource[source]; ok {
+			calendar.Sources = append(calendar.Sources, source)
+			calendar.TotalsBySource[source] = 0
+		}
+	}
+
+	var week ActivityWeek
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(calendarDateFormat)
+		activity := ActivityDay{Date: date, Sources: make(map[string]int)}
+
+		for _, source := range calendar.Sources {
+			if count := bySource[source][date]; count > 0 {
+				activity.Sources[source] = count
+				activity.Count += count
+				calendar.TotalsBySource[source] += count
+			}
+		}
+
+		calendar.TotalActivity += activity.Count
+		week.Days = append(week.Days, activity)
+
+		if day.Weekday() == time.Saturday {
+			calendar.Weeks = append(calendar.Weeks, week)
+			week = ActivityWeek{}
+		}
+	}
+	if len(week.Days) > 0 {
+		calendar.Weeks = append(calendar.Weeks, week)
+	}
+
+	return calendar
+}
+
+// StringValue dereferences an optional string, returning "" for nil
+func StringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
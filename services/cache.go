@@ -0,0 +1,82 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// maxCacheEntries bounds the number of responses kept in memory
+const maxCacheEntries = 10000
+
+// externalCache holds responses from external APIs shared by all services
+var externalCache = newCache()
+
+// cacheEntry is a cached value with an optional expiry
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// expired reports whether the entry has passed its expiry; entries without
+// an expiry never expire
+func (e cacheEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && now.After(e.expiresAt)
+}
+
+// cache is a concurrency-safe in-memory key/value store with per-entry expiry
+type cache struct {
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+// newCache creates an empty cache
+func newCache() *cache {
+	return &cache{entries: make(map[string]cacheEntry)}
+}
+
+// get returns the value stored under key if it exists and has not expired
+func (c *cache) get(key string) (interface{}, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok || entry.expired(time.Now()) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// set stores value under key for ttl; a ttl of zero keeps the value until it
+// is evicted to make room for newer entries
+func (c *cache) set(key string, value interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= maxCacheEntries {
+		c.evict(now)
+	}
+
+	entry := cacheEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = now.Add(ttl)
+	}
+	c.entries[key] = entry
+}
+
+// evict drops expired entries, falling back to arbitrary entries when
+// nothing has expired. Callers must hold the write lock.
+func (c *cache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if entry.expired(now) {
+			delete(c.entries, key)
+		}
+	}
+
+	for key := range c.entries {
+		if len(c.entries) < maxCacheEntries {
+			break
+		}
+		delete(c.entries, key)
+	}
+}
@@ -0,0 +1,40 @@
+package services
+
+import "time"
+
+// calendarDateFormat is the date format used for contribution days
+const calendarDateFormat = "2006-01-02"
+
+// BuildContributionCalendar arranges per-day counts keyed by YYYY-MM-DD into
+// the week-by-week shape GitHub uses, covering the trailing year up to and
+// including today. Weeks start on Sunday, so the first week may begin up to
+// six days before the one-year mark.
+func BuildContributionCalendar(counts map[string]int, today time.Time) *GitHubContributionData {
+	end := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(-1, 0, 1)
+	start = start.AddDate(0, 0, -int(start.Weekday()))
+
+	data := &GitHubContributionData{Weeks: []ContributionWeek{}}
+
+	var week ContributionWeek
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(calendarDateFormat)
+		count := counts[date]
+
+		week.ContributionDays = append(week.ContributionDays, ContributionDay{
+			ContributionCount: count,
+			Date:              date,
+		})
+		data.TotalContributions += count
+
+		if day.Weekday() == time.Saturday {
+			data.Weeks = append(data.Weeks, week)
+			week = ContributionWeek{}
+		}
+	}
+	if len(week.ContributionDays) > 0 {
+		data.Weeks = append(data.Weeks, week)
+	}
+
+	return data
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ryanmello/devboard/httpclient"
+)
+
+const codeforcesAPIEndpoint = "https://codeforces.com/api"
+
+// codeforcesUpstream names the Codeforces API for the outbound client
+const codeforcesUpstream = "Codeforces"
+
+// codeforcesCacheTTL is how long Codeforces responses are cached
+const codeforcesCacheTTL = time.Hour
+
+// CodeforcesStats represents a Codeforces user's rating and solved problems
+type CodeforcesStats struct {
+	Handle         string                  `json:"handle"`
+	Avatar         string                  `json:"avatar"`
+	Rating         int                     `json:"rating"`
+	MaxRating      int                     `json:"maxRating"`
+	Rank           string                  `json:"rank"`
+	MaxRank        string                  `json:"maxRank"`
+	Contribution   int                     `json:"contribution"`
+	TotalSolved    int                     `json:"totalSolved"`
+	UnratedSolved  int                     `json:"unratedSolved"`
+	SolvedByRating []CodeforcesSolvedCount `json:"solvedByRating"`
+}
+
+// CodeforcesSolvedCount is the number of solved problems of a given difficulty
+type CodeforcesSolvedCount struct {
+	Rating int `json:"rating"`
+	Count  int `json:"count"`
+}
+
+// CodeforcesRatingChange represents the rating change from a single contest
+type CodeforcesRatingChange struct {
+	ContestId   int    `json:"contestId"`
+	ContestName string `json:"contestName"`
+	Rank        int    `json:"rank"`
+	OldRating   int    `json:"oldRating"`
+	NewRating   int    `json:"newRating"`
+	UpdatedAt   int64  `json:"updatedAt"`
+}
+
+// codeforcesResponse is the envelope returned by every Codeforces API method
+type codeforcesResponse struct {
+	Status  string          `json:"status"`
+	Comment string          `json:"comment"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// codeforcesUser is a user returned by user.info
+type codeforcesUser struct {
+	Handle       string `json:"handle"`
+	TitlePhoto   string `json:"titlePhoto"`
+	Rating       int    `json:"rating"`
+	MaxRating    int    `json:"maxRating"`
+	Rank         string `json:"rank"`
+	MaxRank      string `json:"maxRank"`
+	Contribution int    `json:"contribution"`
+}
+
+// codeforcesSubmission is a submission returned by user.status
+type codeforcesSubmission struct {
+	CreationTimeSeconds int64  `json:"creationTimeSeconds"`
+	Verdict             string `json:"verdict"`
+	Problem             struct {
+		ContestId      int    `json:"contestId"`
+		ProblemsetName string `json:"problemsetName"`
+		Index          string `json:"index"`
+		Rating         int    `json:"rating"`
+	} `json:"problem"`
+}
+
+// codeforcesRatingChange is an entry returned by user.rating
+type codeforcesRatingChange struct {
+	ContestId               int    `json:"contestId"`
+	ContestName             string `json:"contestName"`
+	Rank                    int    `json:"rank"`
+	RatingUpdateTimeSeconds int64  `json:"ratingUpdateTimeSeconds"`
+	OldRating               int    `json:"oldRating"`
+	NewRating               int    `json:"newRating"`
+}
+
+// CodeforcesService handles Codeforces API interactions
+type CodeforcesService struct {
+	endpoint string
+}
+
+// NewCodeforcesService creates a new Codeforces service instance. The API
+// base URL can be overridden with CODEFORCES_API_URL.
+func NewCodeforcesService() *CodeforcesService {
+	endpoint := os.Getenv("CODEFORCES_API_URL")
+	if endpoint == "" {
+		endpoint = codeforcesAPIEndpoint
+	}
+
+	return &CodeforcesService{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+	}
+}
+
+// GetStats fetches the rating and solved-problem counts for a Codeforces user
+func (s *CodeforcesService) GetStats(ctx context.Context, handle string) (*CodeforcesStats, error) {
+	key := "codeforces:stats:" + strings.ToLower(handle)
+	if cached, ok := externalCache.get(key); ok {
+		return cached.(*CodeforcesStats), nil
+	}
+
+	var users []codeforcesUser
+	if err := s.get(ctx, "user.info", url.Values{"handles": {handle}}, &users); err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("%w: Codeforces user %s", ErrNotFound, handle)
+	}
+	user := users[0]
+
+	submissions, err := s.getSubmissions(ctx, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &CodeforcesStats{
+		Handle:         user.Handle,
+		Avatar:         user.TitlePhoto,
+		Rating:         user.Rating,
+		MaxRating:      user.MaxRating,
+		Rank:           user.Rank,
+		MaxRank:        user.MaxRank,
+		Contribution:   user.Contribution,
+		SolvedByRating: []CodeforcesSolvedCount{},
+	}
+
+	// A problem counts once no matter how many accepted submissions it has
+	solved := make(map[string]bool)
+	byRating := make(map[int]int)
+	for _, sub := range submissions {
+		if sub.Verdict != "OK" {
+			continue
+		}
+
+		problemKey := fmt.Sprintf("%d/%s/%s", sub.Problem.ContestId, sub.Problem.ProblemsetName, sub.Problem.Index)
+		if solved[problemKey] {
+			continue
+		}
+		solved[problemKey] = true
+
+		if sub.Problem.Rating == 0 {
+			stats.UnratedSolved++
+		} else {
+			byRating[sub.Problem.Rating]++
+		}
+	}
+
+	stats.TotalSolved = len(solved)
+	for rating, count := range byRating {
+		stats.SolvedByRating = append(stats.SolvedByRating, CodeforcesSolvedCount{Rating: rating, Count: count})
+	}
+	sort.Slice(stats.SolvedByRating, func(i, j int) bool {
+		return stats.SolvedByRating[i].Rating < stats.SolvedByRating[j].Rating
+	})
+
+	externalCache.set(key, stats, codeforcesCacheTTL)
+	return stats, nil
+}
+
+// GetRatingHistory fetches the rating change from every rated contest a
+// Codeforces user has taken part in, oldest first
+func (s *CodeforcesService) GetRatingHistory(ctx context.Context, handle string) ([]CodeforcesRatingChange, error) {
+	key := "codeforces:rating:" + strings.ToLower(handle)
+	if cached, ok := externalCache.get(key); ok {
+		return cached.([]CodeforcesRatingChange), nil
+	}
+
+	var changes []codeforcesRatingChange
+	if err := s.get(ctx, "user.rating", url.Values{"handle": {handle}}, &changes); err != nil {
+		return nil, err
+	}
+
+	history := make([]CodeforcesRatingChange, 0, len(changes))
+	for _, change := range changes {
+		history = append(history, CodeforcesRatingChange{
+			ContestId:   change.ContestId,
+			ContestName: change.ContestName,
+			Rank:        change.Rank,
+			OldRating:   change.OldRating,
+			NewRating:   change.NewRating,
+			UpdatedAt:   change.RatingUpdateTimeSeconds,
+		})
+	}
+
+	externalCache.set(key, history, codeforcesCacheTTL)
+	return history, nil
+}
+
+// GetSubmissionTimes fetches the Unix time of every submission made by a
+// Codeforces user
+func (s *CodeforcesService) GetSubmissionTimes(ctx context.Context, handle string) ([]int64, error) {
+	key := "codeforces:submissions:" + strings.ToLower(handle)
+	if cached, ok := externalCache.get(key); ok {
+		return cached.([]int64), nil
+	}
+
+	submissions, err := s.getSubmissions(ctx, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	times := make([]int64, 0, len(submissions))
+	for _, sub := range submissions {
+		times = append(times, sub.CreationTimeSeconds)
+	}
+
+	externalCache.set(key, times, codeforcesCacheTTL)
+	return times, nil
+}
+
+// getSubmissions fetches every submission made by a Codeforces user
+func (s *CodeforcesService) getSubmissions(ctx context.Context, handle string) ([]codeforcesSubmission, error) {
+	var submissions []codeforcesSubmission
+	if err := s.get(ctx, "user.status", url.Values{"handle": {handle}}, &submissions); err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+// get calls a Codeforces API method and decodes its result into out
+func (s *CodeforcesService) get(ctx context.Context, method string, params url.Values, out interface{}) error {
+	reqURL := fmt.Sprintf("%s/%s?%s", s.endpoint, method, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := httpclient.Default.Do(ctx, codeforcesUpstream, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Failed calls come back as 400 with the reason in the JSON envelope
+	var result codeforcesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return httpclient.BadResponse(codeforcesUpstream, resp.StatusCode, err)
+	}
+
+	if result.Status != "OK" {
+		if strings.Contains(result.Comment, "not found") {
+			return fmt.Errorf("%w: %s", ErrNotFound, result.Comment)
+		}
+		return httpclient.BadResponse(codeforcesUpstream, resp.StatusCode,
+			fmt.Errorf("Codeforces API error: %s", result.Comment))
+	}
+
+	if err := json.Unmarshal(result.Result, out); err != nil {
+		return httpclient.BadResponse(codeforcesUpstream, resp.StatusCode, err)
+	}
+
+	return nil
+}
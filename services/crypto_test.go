@@ -0,0 +1,109 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// setEncryptionKey configures a 32-byte encryption key derived from the test
+// name, so each test and subtest gets its own key
+func setEncryptionKey(t *testing.T) {
+	t.Helper()
+	t.Setenv("ENCRYPTION_KEY", base64.StdEncoding.EncodeToString([]byte(strings.Repeat(t.Name(), 32)[:32])))
+}
+
+func TestSecretRoundTrip(t *testing.T) {
+	setEncryptionKey(t)
+
+	for _, plaintext := range []string{"", "gho_abc123", strings.Repeat("secret ", 100)} {
+		ciphertext, err := EncryptSecret(plaintext)
+		if err != nil {
+			t.Fatalf("EncryptSecret() error = %v", err)
+		}
+		if plaintext != "" && strings.Contains(ciphertext, plaintext) {
+			t.Errorf("ciphertext %q contains the plaintext", ciphertext)
+		}
+
+		decrypted, err := DecryptSecret(ciphertext)
+		if err != nil {
+			t.Fatalf("DecryptSecret() error = %v", err)
+		}
+		if decrypted != plaintext {
+			t.Errorf("DecryptSecret() = %q, want %q", decrypted, plaintext)
+		}
+	}
+}
+
+func TestEncryptSecretUsesFreshNonces(t *testing.T) {
+	setEncryptionKey(t)
+
+	first, err := EncryptSecret("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := EncryptSecret("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Error("encrypting the same secret twice produced the same ciphertext")
+	}
+}
+
+func TestDecryptSecretRejects(t *testing.T) {
+	setEncryptionKey(t)
+
+	ciphertext, err := EncryptSecret("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, _ := base64.StdEncoding.DecodeString(ciphertext)
+	sealed[len(sealed)-1] ^= 0xff
+	tampered := base64.StdEncoding.EncodeToString(sealed)
+
+	tests := []struct {
+		name       string
+		ciphertext string
+	}{
+		{"tampered ciphertext", tampered},
+		{"invalid base64", "not base64!"},
+		{"too short", base64.StdEncoding.EncodeToString([]byte("short"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecryptSecret(tt.ciphertext); err == nil {
+				t.Error("DecryptSecret() succeeded, want an error")
+			}
+		})
+	}
+
+	t.Run("different key", func(t *testing.T) {
+		setEncryptionKey(t)
+		if _, err := DecryptSecret(ciphertext); err == nil {
+			t.Error("DecryptSecret() succeeded with another key, want an error")
+		}
+	})
+}
+
+func TestEncryptionKeyNotConfigured(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{"unset", ""},
+		{"not base64", "not base64!"},
+		{"wrong length", base64.StdEncoding.EncodeToString([]byte("too short"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENCRYPTION_KEY", tt.key)
+			if _, err := EncryptSecret("token"); !errors.Is(err, ErrNotConfigured) {
+				t.Errorf("EncryptSecret() error = %v, want %v", err, ErrNotConfigured)
+			}
+		})
+	}
+}
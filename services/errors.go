@@ -0,0 +1,15 @@
+package services
+
+import "errors"
+
+// ErrNotFound is returned when an upstream API reports that the requested
+// user or resource does not exist
+var ErrNotFound = errors.New("resource not found")
+
+// ErrNotConfigured is returned when an integration is missing the
+// credentials it needs to call its upstream API
+var ErrNotConfigured = errors.New("integration not configured")
+
+// ErrInvalidCredentials is returned when an upstream API rejects the
+// credentials a user linked
+var ErrInvalidCredentials = errors.New("credentials rejected by upstream")
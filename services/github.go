@@ -2,18 +2,31 @@ package services
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/ryanmello/devboard/httpclient"
 )
 
-const githubGraphQLEndpoint = "https://api.github.com/graphql"
+const githubAPIEndpoint = "https://api.github.com"
+
+// githubUpstream names the GitHub API for the outbound client
+const githubUpstream = "GitHub"
+
+// contributionsCacheTTL is how long calendars that can still change are cached
+const contributionsCacheTTL = time.Hour
 
 const contributionQuery = `
-query($userName: String!) {
+query($userName: String!, $from: DateTime, $to: DateTime) {
     user(login: $userName) {
-        contributionsCollection {
+        contributionsCollection(from: $from, to: $to) {
             contributionCalendar {
                 totalContributions
                 weeks {
@@ -28,6 +41,16 @@ query($userName: String!) {
 }
 `
 
+const contributionYearsQuery = `
+query($userName: String!) {
+    user(login: $userName) {
+        contributionsCollection {
+            contributionYears
+        }
+    }
+}
+`
+
 // ContributionDay represents a single day's contribution data
 type ContributionDay struct {
 	ContributionCount int    `json:"contributionCount"`
@@ -51,93 +74,251 @@ type GitHubContributionData struct {
 	Weeks              []ContributionWeek `json:"weeks"`
 }
 
-// graphqlRequest represents a GitHub GraphQL API request
+// graphqlRequest represents a GraphQL API request
 type graphqlRequest struct {
-	Query     string            `json:"query"`
-	Variables map[string]string `json:"variables"`
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// graphqlError represents a single error returned by a GraphQL API
+type graphqlError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
 }
 
-// graphqlResponse represents the GitHub GraphQL API response
+// graphqlResponse represents a GraphQL API response
 type graphqlResponse struct {
-	Data struct {
-		User struct {
-			ContributionsCollection struct {
-				ContributionCalendar ContributionCalendar `json:"contributionCalendar"`
-			} `json:"contributionsCollection"`
-		} `json:"user"`
-	} `json:"data"`
-	Errors []struct {
-		Message string `json:"message"`
-	} `json:"errors"`
+	Data   json.RawMessage `json:"data"`
+	Errors []graphqlError  `json:"errors"`
+}
+
+// contributionResponse is the data payload of a contribution query
+type contributionResponse struct {
+	User struct {
+		ContributionsCollection struct {
+			ContributionCalendar ContributionCalendar `json:"contributionCalendar"`
+		} `json:"contributionsCollection"`
+	} `json:"user"`
+}
+
+// contributionYearsResponse is the data payload of a contribution years query
+type contributionYearsResponse struct {
+	User struct {
+		ContributionsCollection struct {
+			ContributionYears []int `json:"contributionYears"`
+		} `json:"contributionsCollection"`
+	} `json:"user"`
 }
 
 // GitHubService handles GitHub API interactions
 type GitHubService struct {
-	token string
+	token    string
+	endpoint string
+
+	// cacheScope keeps responses fetched with a user's own token, which can
+	// include private contributions, apart from those fetched with the
+	// shared token
+	cacheScope string
+
+	// fallback is used when GitHub rejects the token, so a revoked user
+	// token degrades to the shared one instead of failing
+	fallback *GitHubService
+
+	// rejected is called when GitHub rejects the token, so a dead user token
+	// can be forgotten instead of being tried on every request
+	rejected func()
 }
 
-// NewGitHubService creates a new GitHub service instance
+// NewGitHubService creates a GitHub service using the shared GITHUB_TOKEN.
+// The API base URL can be overridden with GITHUB_API_URL.
 func NewGitHubService() *GitHubService {
 	return &GitHubService{
-		token: os.Getenv("GITHUB_TOKEN"),
+		token:      os.Getenv("GITHUB_TOKEN"),
+		endpoint:   githubAPIURL(),
+		cacheScope: "shared",
+	}
+}
+
+// NewGitHubServiceWithToken creates a GitHub service that authenticates with
+// a user's own OAuth token, falling back to the shared token if GitHub
+// rejects it
+func NewGitHubServiceWithToken(token string) *GitHubService {
+	sum := sha256.Sum256([]byte(token))
+	return &GitHubService{
+		token:      token,
+		endpoint:   githubAPIURL(),
+		cacheScope: "user-" + hex.EncodeToString(sum[:8]),
+		fallback:   NewGitHubService(),
+	}
+}
+
+// githubAPIURL returns the GitHub API base URL
+func githubAPIURL() string {
+	endpoint := os.Getenv("GITHUB_API_URL")
+	if endpoint == "" {
+		endpoint = githubAPIEndpoint
 	}
+	return strings.TrimSuffix(endpoint, "/")
+}
+
+// cacheKey builds a cache key scoped to the token the service uses
+func (s *GitHubService) cacheKey(parts ...string) string {
+	return "github:" + s.cacheScope + ":" + strings.Join(parts, ":")
 }
 
-// GetContributions fetches the contribution data for a GitHub user
-func (s *GitHubService) GetContributions(username string) (*GitHubContributionData, error) {
+// GetContributions fetches the contribution data for a GitHub user over the
+// trailing year
+func (s *GitHubService) GetContributions(ctx context.Context, username string) (*GitHubContributionData, error) {
+	key := s.cacheKey("contributions", strings.ToLower(username))
+	if cached, ok := externalCache.get(key); ok {
+		return cached.(*GitHubContributionData), nil
+	}
+
+	data, err := s.fetchContributions(ctx, map[string]interface{}{"userName": username})
+	if err != nil {
+		return nil, err
+	}
+
+	externalCache.set(key, data, contributionsCacheTTL)
+	return data, nil
+}
+
+// GetContributionsInRange fetches the contribution data for a GitHub user
+// between from and to. GitHub rejects ranges longer than one year.
+func (s *GitHubService) GetContributionsInRange(ctx context.Context, username string, from, to time.Time) (*GitHubContributionData, error) {
+	from, to = from.UTC(), to.UTC()
+
+	key := s.cacheKey("contributions", strings.ToLower(username), from.Format(time.RFC3339), to.Format(time.RFC3339))
+	if cached, ok := externalCache.get(key); ok {
+		return cached.(*GitHubContributionData), nil
+	}
+
+	data, err := s.fetchContributions(ctx, map[string]interface{}{
+		"userName": username,
+		"from":     from.Format(time.RFC3339),
+		"to":       to.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Calendars for past years no longer change, so keep them indefinitely
+	ttl := contributionsCacheTTL
+	if to.Year() < time.Now().UTC().Year() {
+		ttl = 0
+	}
+
+	externalCache.set(key, data, ttl)
+	return data, nil
+}
+
+// GetContributionsForYear fetches the contribution data for a GitHub user for
+// a single calendar year
+func (s *GitHubService) GetContributionsForYear(ctx context.Context, username string, year int) (*GitHubContributionData, error) {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0).Add(-time.Second)
+	return s.GetContributionsInRange(ctx, username, from, to)
+}
+
+// GetContributionYears fetches the years in which a GitHub user has
+// contributions, most recent first
+func (s *GitHubService) GetContributionYears(ctx context.Context, username string) ([]int, error) {
+	key := s.cacheKey("contribution-years", strings.ToLower(username))
+	if cached, ok := externalCache.get(key); ok {
+		return cached.([]int), nil
+	}
+
+	var data contributionYearsResponse
+	if err := s.query(ctx, contributionYearsQuery, map[string]interface{}{"userName": username}, &data); err != nil {
+		return nil, err
+	}
+
+	years := data.User.ContributionsCollection.ContributionYears
+	if years == nil {
+		years = []int{}
+	}
+
+	externalCache.set(key, years, contributionsCacheTTL)
+	return years, nil
+}
+
+// fetchContributions runs the contribution query with the given variables
+func (s *GitHubService) fetchContributions(ctx context.Context, variables map[string]interface{}) (*GitHubContributionData, error) {
+	var data contributionResponse
+	if err := s.query(ctx, contributionQuery, variables, &data); err != nil {
+		return nil, err
+	}
+
+	calendar := data.User.ContributionsCollection.ContributionCalendar
+
+	return &GitHubContributionData{
+		TotalContributions: calendar.TotalContributions,
+		Weeks:              calendar.Weeks,
+	}, nil
+}
+
+// query executes a GraphQL query against the GitHub API and decodes the
+// data payload into out
+func (s *GitHubService) query(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
 	if s.token == "" {
-		return nil, fmt.Errorf("GITHUB_TOKEN environment variable is not set")
+		return fmt.Errorf("%w: GITHUB_TOKEN environment variable is not set", ErrNotConfigured)
 	}
 
 	// Build the GraphQL request
 	reqBody := graphqlRequest{
-		Query: contributionQuery,
-		Variables: map[string]string{
-			"userName": username,
-		},
+		Query:     query,
+		Variables: variables,
 	}
 
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest("POST", githubGraphQLEndpoint, bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", s.endpoint+"/graphql", bytes.NewBuffer(jsonBody))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+s.token)
 	req.Header.Set("Content-Type", "application/json")
 
 	// Execute request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := httpclient.Default.Do(ctx, githubUpstream, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized && s.fallback != nil {
+		if s.rejected != nil {
+			s.rejected()
+		}
+		return s.fallback.query(ctx, query, variables, out)
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API returned status %d", resp.StatusCode)
+		return httpclient.BadResponse(githubUpstream, resp.StatusCode, nil)
 	}
 
 	// Parse response
 	var result graphqlResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		return httpclient.BadResponse(githubUpstream, resp.StatusCode, err)
 	}
 
 	// Check for GraphQL errors
 	if len(result.Errors) > 0 {
-		return nil, fmt.Errorf("GitHub API error: %s", result.Errors[0].Message)
+		if result.Errors[0].Type == "NOT_FOUND" {
+			return fmt.Errorf("%w: %s", ErrNotFound, result.Errors[0].Message)
+		}
+		return httpclient.BadResponse(githubUpstream, resp.StatusCode,
+			fmt.Errorf("GitHub API error: %s", result.Errors[0].Message))
 	}
 
-	calendar := result.Data.User.ContributionsCollection.ContributionCalendar
+	if err := json.Unmarshal(result.Data, out); err != nil {
+		return httpclient.BadResponse(githubUpstream, resp.StatusCode, err)
+	}
 
-	return &GitHubContributionData{
-		TotalContributions: calendar.TotalContributions,
-		Weeks:              calendar.Weeks,
-	}, nil
+	return nil
 }
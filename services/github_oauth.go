@@ -0,0 +1,431 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/httpclient"
+	"gorm.io/gorm"
+)
+
+const githubOAuthEndpoint = "https://github.com"
+
+// githubOAuthUpstream names the GitHub OAuth endpoints for the outbound client
+const githubOAuthUpstream = "GitHub OAuth"
+
+// githubOAuthScope lets contribution queries include private contributions
+const githubOAuthScope = "read:user"
+
+// githubOAuthStateTTL is how long an authorization request stays valid
+const githubOAuthStateTTL = 10 * time.Minute
+
+// ErrInvalidOAuthState is returned when the state echoed back by GitHub was
+// not issued to the current user or has expired
+var ErrInvalidOAuthState = errors.New("invalid OAuth state")
+
+// ErrInvalidOAuthGrant is returned when GitHub rejects an authorization code
+// or refresh token
+var ErrInvalidOAuthGrant = errors.New("invalid OAuth grant")
+
+// GitHubToken is a token issued by the GitHub OAuth flow. RefreshToken and
+// ExpiresAt are only set when the app issues expiring tokens.
+type GitHubToken struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    *time.Time
+	Scope        string
+}
+
+// githubTokenResponse is the response from the access token endpoint
+type githubTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	Scope            string `json:"scope"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// GitHubOAuth handles the GitHub OAuth web flow for linking user accounts
+type GitHubOAuth struct {
+	clientId     string
+	clientSecret string
+	redirectURL  string
+	oauthURL     string
+	apiURL       string
+}
+
+// NewGitHubOAuth creates a GitHub OAuth client from GITHUB_CLIENT_ID,
+// GITHUB_CLIENT_SECRET and the optional GITHUB_OAUTH_REDIRECT_URL. The
+// authorization server can be overridden with GITHUB_OAUTH_URL and the API
+// with GITHUB_API_URL.
+func NewGitHubOAuth() (*GitHubOAuth, error) {
+	clientId := os.Getenv("GITHUB_CLIENT_ID")
+	clientSecret := os.Getenv("GITHUB_CLIENT_SECRET")
+	if clientId == "" || clientSecret == "" {
+		return nil, fmt.Errorf("%w: GITHUB_CLIENT_ID and GITHUB_CLIENT_SECRET must be set", ErrNotConfigured)
+	}
+
+	oauthURL := os.Getenv("GITHUB_OAUTH_URL")
+	if oauthURL == "" {
+		oauthURL = githubOAuthEndpoint
+	}
+
+	return &GitHubOAuth{
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		redirectURL:  os.Getenv("GITHUB_OAUTH_REDIRECT_URL"),
+		oauthURL:     strings.TrimSuffix(oauthURL, "/"),
+		apiURL:       githubAPIURL(),
+	}, nil
+}
+
+// AuthorizeURL returns the GitHub page that asks the user to authorize the
+// app, carrying a signed state bound to userId
+func (o *GitHubOAuth) AuthorizeURL(userId string) (string, error) {
+	state, err := o.signState(userId, time.Now().Add(githubOAuthStateTTL))
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{
+		"client_id":    {o.clientId},
+		"scope":        {githubOAuthScope},
+		"state":        {state},
+		"allow_signup": {"false"},
+	}
+	if o.redirectURL != "" {
+		params.Set("redirect_uri", o.redirectURL)
+	}
+
+	return o.oauthURL + "/login/oauth/authorize?" + params.Encode(), nil
+}
+
+// VerifyState checks that a state returned by GitHub was issued to userId
+// and has not expired
+func (o *GitHubOAuth) VerifyState(state, userId string) error {
+	payload, signature, ok := strings.Cut(state, ".")
+	if !ok {
+		return ErrInvalidOAuthState
+	}
+
+	expected := o.sign(payload)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return ErrInvalidOAuthState
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return ErrInvalidOAuthState
+	}
+
+	// Payload is userId|expiry|nonce
+	fields := strings.Split(string(decoded), "|")
+	if len(fields) != 3 || fields[0] != userId {
+		return ErrInvalidOAuthState
+	}
+
+	expiry, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil || time.Now().Unix() > expiry {
+		return ErrInvalidOAuthState
+	}
+
+	return nil
+}
+
+// Exchange trades an authorization code for a token
+func (o *GitHubOAuth) Exchange(ctx context.Context, code string) (*GitHubToken, error) {
+	params := url.Values{"code": {code}}
+	if o.redirectURL != "" {
+		params.Set("redirect_uri", o.redirectURL)
+	}
+	return o.requestToken(ctx, params)
+}
+
+// Refresh trades a refresh token for a new token. GitHub refresh tokens are
+// single use, so the returned refresh token replaces the old one.
+func (o *GitHubOAuth) Refresh(ctx context.Context, refreshToken string) (*GitHubToken, error) {
+	return o.requestToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+// Revoke deletes the app's authorization for the user that owns
+// accessToken, invalidating all of its tokens. Tokens GitHub no longer
+// recognises are treated as already revoked.
+func (o *GitHubOAuth) Revoke(ctx context.Context, accessToken string) error {
+	body, err := json.Marshal(map[string]string{"access_token": accessToken})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	reqURL := fmt.Sprintf("%s/applications/%s/grant", o.apiURL, url.PathEscape(o.clientId))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", reqURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(o.clientId, o.clientSecret)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpclient.Default.Do(ctx, githubUpstream, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent, http.StatusNotFound, http.StatusUnprocessableEntity:
+		return nil
+	default:
+		return httpclient.BadResponse(githubUpstream, resp.StatusCode, nil)
+	}
+}
+
+// requestToken calls the access token endpoint with the client credentials
+// and the given grant parameters
+func (o *GitHubOAuth) requestToken(ctx context.Context, params url.Values) (*GitHubToken, error) {
+	params.Set("client_id", o.clientId)
+	params.Set("client_secret", o.clientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", o.oauthURL+"/login/oauth/access_token",
+		strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpclient.Default.Do(ctx, githubOAuthUpstream, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpclient.BadResponse(githubOAuthUpstream, resp.StatusCode, nil)
+	}
+
+	// Rejected grants still come back as 200 with an error field
+	var result githubTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, httpclient.BadResponse(githubOAuthUpstream, resp.StatusCode, err)
+	}
+	if result.Error != "" {
+		if result.Error == "bad_verification_code" || result.Error == "bad_refresh_token" {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidOAuthGrant, result.ErrorDescription)
+		}
+		return nil, httpclient.BadResponse(githubOAuthUpstream, resp.StatusCode,
+			fmt.Errorf("GitHub OAuth error: %s", result.Error))
+	}
+	if result.AccessToken == "" {
+		return nil, httpclient.BadResponse(githubOAuthUpstream, resp.StatusCode, errors.New("no access token returned"))
+	}
+
+	token := &GitHubToken{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		Scope:        result.Scope,
+	}
+	if result.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+		token.ExpiresAt = &expiresAt
+	}
+
+	return token, nil
+}
+
+// signState builds a state value of the form payload.signature, where the
+// payload carries the user id, an expiry and a random nonce
+func (o *GitHubOAuth) signState(userId string, expiresAt time.Time) (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate state: %w", err)
+	}
+
+	raw := fmt.Sprintf("%s|%d|%s", userId, expiresAt.Unix(), hex.EncodeToString(nonce))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(raw))
+	return payload + "." + o.sign(payload), nil
+}
+
+// sign returns the HMAC-SHA256 of a state payload keyed by the client secret
+func (o *GitHubOAuth) sign(payload string) string {
+	mac := hmac.New(sha256.New, []byte(o.clientSecret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+const githubViewerQuery = `
+query {
+    viewer {
+        login
+    }
+}
+`
+
+// githubViewerResponse is the data payload of a viewer query
+type githubViewerResponse struct {
+	Viewer struct {
+		Login string `json:"login"`
+	} `json:"viewer"`
+}
+
+// GetViewerLogin returns the login of the user the service's token belongs to
+func (s *GitHubService) GetViewerLogin(ctx context.Context) (string, error) {
+	// Only meaningful for the token being checked, never the shared one
+	service := *s
+	service.fallback = nil
+
+	var data githubViewerResponse
+	if err := service.query(ctx, githubViewerQuery, nil, &data); err != nil {
+		return "", err
+	}
+	return data.Viewer.Login, nil
+}
+
+// githubRefreshWindow is how close to expiry a stored token is refreshed
+const githubRefreshWindow = 5 * time.Minute
+
+// githubRefreshMu serialises token refreshes, since GitHub refresh tokens
+// can only be used once
+var githubRefreshMu sync.Mutex
+
+// GitHubServiceForUser returns a GitHub service that uses the user's linked
+// OAuth token, refreshing it first if it is about to expire. It falls back
+// to the shared token when no account is linked or the stored token cannot
+// be used, and unlinks the account if GitHub rejects its token.
+func GitHubServiceForUser(ctx context.Context, userId string) *GitHubService {
+	var account db.ConnectedAccount
+	if err := db.GetDB().Where("user_id = ? AND provider = ?", userId, db.ConnectedAccountGitHub).
+		First(&account).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("Failed to load GitHub account for user %s: %v", userId, err)
+		}
+		return NewGitHubService()
+	}
+
+	if tokenExpiring(&account) {
+		refreshed, err := refreshGitHubAccount(ctx, userId)
+		if err != nil {
+			log.Printf("Failed to refresh GitHub token for user %s: %v", userId, err)
+			return NewGitHubService()
+		}
+		account = *refreshed
+	}
+
+	token, err := DecryptSecret(account.EncryptedToken)
+	if err != nil {
+		log.Printf("Failed to decrypt GitHub token for user %s: %v", userId, err)
+		return NewGitHubService()
+	}
+
+	service := NewGitHubServiceWithToken(token)
+	service.rejected = func() { forgetGitHubAccount(&account) }
+	return service
+}
+
+// forgetGitHubAccount deletes a linked account whose token GitHub has
+// rejected, so later requests go straight to the shared token. The token is
+// matched too, so an account the user has since linked again is kept.
+func forgetGitHubAccount(account *db.ConnectedAccount) {
+	log.Printf("GitHub rejected the token for user %s, unlinking it", account.UserId)
+	if err := db.GetDB().Where("id = ? AND encrypted_token = ?", account.Id, account.EncryptedToken).
+		Delete(&db.ConnectedAccount{}).Error; err != nil {
+		log.Printf("Failed to unlink GitHub account for user %s: %v", account.UserId, err)
+	}
+}
+
+// refreshGitHubAccount trades the stored refresh token for a new token and
+// saves it. The account is reloaded under the lock so a concurrent request
+// that already refreshed it is not repeated with a spent refresh token.
+func refreshGitHubAccount(ctx context.Context, userId string) (*db.ConnectedAccount, error) {
+	githubRefreshMu.Lock()
+	defer githubRefreshMu.Unlock()
+
+	var account db.ConnectedAccount
+	if err := db.GetDB().Where("user_id = ? AND provider = ?", userId, db.ConnectedAccountGitHub).
+		First(&account).Error; err != nil {
+		return nil, err
+	}
+	if !tokenExpiring(&account) {
+		return &account, nil
+	}
+	if account.EncryptedRefreshToken == nil {
+		return nil, errors.New("token expired and no refresh token is stored")
+	}
+
+	refreshToken, err := DecryptSecret(*account.EncryptedRefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	oauth, err := NewGitHubOAuth()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauth.Refresh(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SetGitHubToken(&account, token); err != nil {
+		return nil, err
+	}
+	if err := db.GetDB().Save(&account).Error; err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// SetGitHubToken encrypts a token onto a connected account
+func SetGitHubToken(account *db.ConnectedAccount, token *GitHubToken) error {
+	encrypted, err := EncryptSecret(token.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	var encryptedRefresh *string
+	if token.RefreshToken != "" {
+		value, err := EncryptSecret(token.RefreshToken)
+		if err != nil {
+			return err
+		}
+		encryptedRefresh = &value
+	}
+
+	account.EncryptedToken = encrypted
+	account.EncryptedRefreshToken = encryptedRefresh
+	account.ExpiresAt = token.ExpiresAt
+	account.Scope = nil
+	if token.Scope != "" {
+		account.Scope = &token.Scope
+	}
+
+	return nil
+}
+
+// tokenExpiring reports whether a stored token expires within the refresh
+// window. Tokens without an expiry never need refreshing.
+func tokenExpiring(account *db.ConnectedAccount) bool {
+	return account.ExpiresAt != nil && time.Until(*account.ExpiresAt) < githubRefreshWindow
+}
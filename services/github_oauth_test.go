@@ -0,0 +1,286 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryanmello/devboard/db"
+)
+
+const (
+	testGitHubClientId     = "client-id"
+	testGitHubClientSecret = "client-secret"
+)
+
+// newTestGitHubOAuth configures a GitHub OAuth client whose authorization
+// server and API are both served by handler
+func newTestGitHubOAuth(t *testing.T, handler http.HandlerFunc) *GitHubOAuth {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	t.Setenv("GITHUB_CLIENT_ID", testGitHubClientId)
+	t.Setenv("GITHUB_CLIENT_SECRET", testGitHubClientSecret)
+	t.Setenv("GITHUB_OAUTH_REDIRECT_URL", "https://devboard.test/callback")
+	t.Setenv("GITHUB_OAUTH_URL", server.URL+"/")
+	t.Setenv("GITHUB_API_URL", server.URL)
+
+	oauth, err := NewGitHubOAuth()
+	if err != nil {
+		t.Fatalf("NewGitHubOAuth() error = %v", err)
+	}
+	return oauth
+}
+
+// tokenEndpoint serves the access token endpoint, checking the client
+// credentials and passing the grant parameters to respond
+func tokenEndpoint(t *testing.T, respond func(form url.Values) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/login/oauth/access_token" {
+			t.Errorf("request = %s %s, want POST /login/oauth/access_token", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse form: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("client_id") != testGitHubClientId || r.PostForm.Get("client_secret") != testGitHubClientSecret {
+			t.Errorf("client credentials = %q, %q", r.PostForm.Get("client_id"), r.PostForm.Get("client_secret"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, respond(r.PostForm))
+	}
+}
+
+func TestNewGitHubOAuthNotConfigured(t *testing.T) {
+	t.Setenv("GITHUB_CLIENT_ID", testGitHubClientId)
+	t.Setenv("GITHUB_CLIENT_SECRET", "")
+
+	if _, err := NewGitHubOAuth(); !errors.Is(err, ErrNotConfigured) {
+		t.Errorf("NewGitHubOAuth() error = %v, want %v", err, ErrNotConfigured)
+	}
+}
+
+func TestGitHubOAuthAuthorizeURL(t *testing.T) {
+	oauth := newTestGitHubOAuth(t, http.NotFound)
+
+	authorizeURL, err := oauth.AuthorizeURL("user-1")
+	if err != nil {
+		t.Fatalf("AuthorizeURL() error = %v", err)
+	}
+
+	parsed, err := url.Parse(authorizeURL)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", authorizeURL, err)
+	}
+	if parsed.Path != "/login/oauth/authorize" {
+		t.Errorf("path = %q, want /login/oauth/authorize", parsed.Path)
+	}
+
+	query := parsed.Query()
+	if query.Get("client_id") != testGitHubClientId || query.Get("scope") != githubOAuthScope ||
+		query.Get("redirect_uri") != "https://devboard.test/callback" {
+		t.Errorf("query = %v", query)
+	}
+	if err := oauth.VerifyState(query.Get("state"), "user-1"); err != nil {
+		t.Errorf("VerifyState() error = %v for the issued state", err)
+	}
+}
+
+func TestGitHubOAuthVerifyState(t *testing.T) {
+	oauth := newTestGitHubOAuth(t, http.NotFound)
+	other := *oauth
+	other.clientSecret = "another-secret"
+
+	valid, err := oauth.signState("user-1", time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := oauth.signState("user-1", time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign, err := other.signState("user-1", time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, _, _ := strings.Cut(valid, ".")
+
+	tests := []struct {
+		name    string
+		state   string
+		userId  string
+		wantErr bool
+	}{
+		{"valid", valid, "user-1", false},
+		{"issued to another user", valid, "user-2", true},
+		{"expired", expired, "user-1", true},
+		{"signed with another secret", foreign, "user-1", true},
+		{"tampered signature", payload + ".AAAA", "user-1", true},
+		{"missing signature", payload, "user-1", true},
+		{"empty", "", "user-1", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := oauth.VerifyState(tt.state, tt.userId)
+			if tt.wantErr && !errors.Is(err, ErrInvalidOAuthState) {
+				t.Errorf("VerifyState() error = %v, want %v", err, ErrInvalidOAuthState)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("VerifyState() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestGitHubOAuthExchange(t *testing.T) {
+	oauth := newTestGitHubOAuth(t, tokenEndpoint(t, func(form url.Values) string {
+		if form.Get("redirect_uri") != "https://devboard.test/callback" {
+			t.Errorf("redirect_uri = %q", form.Get("redirect_uri"))
+		}
+		if form.Get("code") != "good-code" {
+			return `{"error": "bad_verification_code", "error_description": "The code passed is incorrect or expired."}`
+		}
+		return `{"access_token": "ghu_access", "refresh_token": "ghr_refresh", "expires_in": 28800, "scope": "read:user"}`
+	}))
+
+	token, err := oauth.Exchange(context.Background(), "good-code")
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if token.AccessToken != "ghu_access" || token.RefreshToken != "ghr_refresh" || token.Scope != "read:user" {
+		t.Errorf("token = %+v", token)
+	}
+	if token.ExpiresAt == nil || time.Until(*token.ExpiresAt) < 7*time.Hour {
+		t.Errorf("ExpiresAt = %v, want about eight hours from now", token.ExpiresAt)
+	}
+
+	if _, err := oauth.Exchange(context.Background(), "bad-code"); !errors.Is(err, ErrInvalidOAuthGrant) {
+		t.Errorf("Exchange() error = %v, want %v", err, ErrInvalidOAuthGrant)
+	}
+}
+
+func TestGitHubOAuthRefresh(t *testing.T) {
+	oauth := newTestGitHubOAuth(t, tokenEndpoint(t, func(form url.Values) string {
+		if form.Get("grant_type") != "refresh_token" {
+			t.Errorf("grant_type = %q, want refresh_token", form.Get("grant_type"))
+		}
+		if form.Get("refresh_token") != "ghr_old" {
+			return `{"error": "bad_refresh_token", "error_description": "The refresh token passed is incorrect or expired."}`
+		}
+		return `{"access_token": "ghu_new", "refresh_token": "ghr_new", "expires_in": 28800}`
+	}))
+	setEncryptionKey(t)
+
+	expiresAt := time.Now().Add(time.Minute)
+	account := &db.ConnectedAccount{ExpiresAt: &expiresAt}
+	if !tokenExpiring(account) {
+		t.Fatal("tokenExpiring() = false for a token expiring within the refresh window")
+	}
+
+	token, err := oauth.Refresh(context.Background(), "ghr_old")
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if token.AccessToken != "ghu_new" || token.RefreshToken != "ghr_new" {
+		t.Errorf("token = %+v", token)
+	}
+
+	if err := SetGitHubToken(account, token); err != nil {
+		t.Fatalf("SetGitHubToken() error = %v", err)
+	}
+	if tokenExpiring(account) {
+		t.Error("tokenExpiring() = true after refreshing")
+	}
+	if access, err := DecryptSecret(account.EncryptedToken); err != nil || access != "ghu_new" {
+		t.Errorf("stored access token = %q, %v, want ghu_new", access, err)
+	}
+	if account.EncryptedRefreshToken == nil {
+		t.Fatal("no refresh token stored")
+	}
+	if refresh, err := DecryptSecret(*account.EncryptedRefreshToken); err != nil || refresh != "ghr_new" {
+		t.Errorf("stored refresh token = %q, %v, want ghr_new", refresh, err)
+	}
+
+	if _, err := oauth.Refresh(context.Background(), "ghr_spent"); !errors.Is(err, ErrInvalidOAuthGrant) {
+		t.Errorf("Refresh() error = %v, want %v", err, ErrInvalidOAuthGrant)
+	}
+}
+
+func TestTokenExpiring(t *testing.T) {
+	at := func(d time.Duration) *time.Time {
+		expiresAt := time.Now().Add(d)
+		return &expiresAt
+	}
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+		want      bool
+	}{
+		{"no expiry", nil, false},
+		{"expires later", at(time.Hour), false},
+		{"expires within the refresh window", at(time.Minute), true},
+		{"already expired", at(-time.Minute), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenExpiring(&db.ConnectedAccount{ExpiresAt: tt.expiresAt}); got != tt.want {
+				t.Errorf("tokenExpiring() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitHubOAuthRevoke(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"revoked", http.StatusNoContent, false},
+		{"unknown token", http.StatusNotFound, false},
+		{"already revoked", http.StatusUnprocessableEntity, false},
+		{"bad client credentials", http.StatusUnauthorized, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oauth := newTestGitHubOAuth(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/applications/"+testGitHubClientId+"/grant" {
+					t.Errorf("request = %s %s", r.Method, r.URL.Path)
+				}
+				if id, secret, ok := r.BasicAuth(); !ok || id != testGitHubClientId || secret != testGitHubClientSecret {
+					t.Errorf("basic auth = %q, %q, %v", id, secret, ok)
+				}
+				var body map[string]string
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["access_token"] != "ghu_access" {
+					t.Errorf("body = %v, %v, want the access token", body, err)
+				}
+				w.WriteHeader(tt.status)
+			})
+
+			err := oauth.Revoke(context.Background(), "ghu_access")
+			if tt.wantErr && err == nil {
+				t.Error("Revoke() succeeded, want an error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Revoke() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const openSourceSearchQuery = `
+query($query: String!, $cursor: String) {
+    search(query: $query, type: ISSUE, first: 100, after: $cursor) {
+        pageInfo {
+            hasNextPage
+            endCursor
+        }
+        nodes {
+            ... on PullRequest {
+                title
+                url
+                number
+                mergedAt
+                repository {
+                    nameWithOwner
+                    url
+                    description
+                    stargazerCount
+                    isPrivate
+                    owner {
+                        login
+                    }
+                    primaryLanguage {
+                        name
+                    }
+                }
+            }
+        }
+    }
+}
+`
+
+const (
+	// openSourceMaxPullRequests bounds how many merged pull requests are read
+	// from the search API; GitHub stops returning results after 1000 anyway
+	openSourceMaxPullRequests = 500
+
+	// openSourcePullRequestsPerRepo is the number of recent pull requests
+	// listed under each repository
+	openSourcePullRequestsPerRepo = 5
+)
+
+// githubLoginPattern matches a valid GitHub login, which keeps arbitrary
+// qualifiers out of the search query built from it
+var githubLoginPattern = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?$`)
+
+// OpenSourceRepository is a repository the user does not own that has merged
+// pull requests from them
+type OpenSourceRepository struct {
+	FullName           string                  `json:"fullName" example:"golang/go"`
+	URL                string                  `json:"url"`
+	Description        *string                 `json:"description"`
+	Stars              int                     `json:"stars" example:"120000"`
+	PrimaryLanguage    *string                 `json:"primaryLanguage" example:"Go"`
+	MergedPullRequests int                     `json:"mergedPullRequests" example:"3"`
+	LastMergedAt       *time.Time              `json:"lastMergedAt"`
+	PullRequests       []OpenSourcePullRequest `json:"pullRequests"`
+}
+
+// OpenSourcePullRequest is a merged pull request to another owner's repository
+type OpenSourcePullRequest struct {
+	Title    string     `json:"title"`
+	URL      string     `json:"url"`
+	Number   int        `json:"number"`
+	MergedAt *time.Time `json:"mergedAt"`
+}
+
+// OpenSourceContributions groups a user's merged pull requests by repository
+type OpenSourceContributions struct {
+	TotalPullRequests int                    `json:"totalPullRequests"`
+	Repositories      []OpenSourceRepository `json:"repositories"`
+}
+
+// openSourceSearchResponse is the data payload of an open-source search query
+type openSourceSearchResponse struct {
+	Search struct {
+		PageInfo struct {
+			HasNextPage bool   `json:"hasNextPage"`
+			EndCursor   string `json:"endCursor"`
+		} `json:"pageInfo"`
+		Nodes []struct {
+			Title      string     `json:"title"`
+			URL        string     `json:"url"`
+			Number     int        `json:"number"`
+			MergedAt   *time.Time `json:"mergedAt"`
+			Repository *struct {
+				NameWithOwner  string  `json:"nameWithOwner"`
+				URL            string  `json:"url"`
+				Description    *string `json:"description"`
+				StargazerCount int     `json:"stargazerCount"`
+				IsPrivate      bool    `json:"isPrivate"`
+				Owner          struct {
+					Login string `json:"login"`
+				} `json:"owner"`
+				PrimaryLanguage *struct {
+					Name string `json:"name"`
+				} `json:"primaryLanguage"`
+			} `json:"repository"`
+		} `json:"nodes"`
+	} `json:"search"`
+}
+
+// GetOpenSourceContributions fetches the user's merged pull requests to
+// repositories owned by someone else, grouped by repository and sorted by
+// star count
+func (s *GitHubService) GetOpenSourceContributions(ctx context.Context, username string) (*OpenSourceContributions, error) {
+	key := s.cacheKey("opensource", strings.ToLower(username))
+	if cached, ok := externalCache.get(key); ok {
+		return cached.(*OpenSourceContributions), nil
+	}
+
+	if !githubLoginPattern.MatchString(username) {
+		return nil, fmt.Errorf("%w: invalid GitHub login %q", ErrNotFound, username)
+	}
+
+	variables := map[string]interface{}{
+		"query": "is:pr is:merged author:" + username + " -user:" + username,
+	}
+
+	repos := make(map[string]*OpenSourceRepository)
+	result := &OpenSourceContributions{Repositories: []OpenSourceRepository{}}
+
+	for fetched := 0; fetched < openSourceMaxPullRequests; {
+		var data openSourceSearchResponse
+		if err := s.query(ctx, openSourceSearchQuery, variables, &data); err != nil {
+			return nil, err
+		}
+
+		for _, pr := range data.Search.Nodes {
+			fetched++
+
+			// Private repositories can show up when the token has access
+			// to them, and the owner check backs up the search filter
+			repo := pr.Repository
+			if repo == nil || repo.IsPrivate || strings.EqualFold(repo.Owner.Login, username) {
+				continue
+			}
+
+			group, ok := repos[repo.NameWithOwner]
+			if !ok {
+				group = &OpenSourceRepository{
+					FullName:     repo.NameWithOwner,
+					URL:          repo.URL,
+					Description:  repo.Description,
+					Stars:        repo.StargazerCount,
+					PullRequests: []OpenSourcePullRequest{},
+				}
+				if repo.PrimaryLanguage != nil {
+					language := repo.PrimaryLanguage.Name
+					group.PrimaryLanguage = &language
+				}
+				repos[repo.NameWithOwner] = group
+			}
+
+			group.MergedPullRequests++
+			group.PullRequests = append(group.PullRequests, OpenSourcePullRequest{
+				Title:    pr.Title,
+				URL:      pr.URL,
+				Number:   pr.Number,
+				MergedAt: pr.MergedAt,
+			})
+			if pr.MergedAt != nil && (group.LastMergedAt == nil || pr.MergedAt.After(*group.LastMergedAt)) {
+				group.LastMergedAt = pr.MergedAt
+			}
+			result.TotalPullRequests++
+		}
+
+		if !data.Search.PageInfo.HasNextPage || len(data.Search.Nodes) == 0 {
+			break
+		}
+		variables["cursor"] = data.Search.PageInfo.EndCursor
+	}
+
+	for _, group := range repos {
+		sort.Slice(group.PullRequests, func(i, j int) bool {
+			return mergedAfter(group.PullRequests[i].MergedAt, group.PullRequests[j].MergedAt)
+		})
+		if len(group.PullRequests) > openSourcePullRequestsPerRepo {
+			group.PullRequests = group.PullRequests[:openSourcePullRequestsPerRepo]
+		}
+		result.Repositories = append(result.Repositories, *group)
+	}
+
+	sort.Slice(result.Repositories, func(i, j int) bool {
+		a, b := result.Repositories[i], result.Repositories[j]
+		if a.Stars != b.Stars {
+			return a.Stars > b.Stars
+		}
+		return a.FullName < b.FullName
+	})
+
+	externalCache.set(key, result, contributionsCacheTTL)
+	return result, nil
+}
+
+// mergedAfter orders merge times newest first, with unknown times last
+func mergedAfter(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a != nil
+	}
+	return a.After(*b)
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const repositoryQuery = `
+query($owner: String!, $name: String!) {
+    repository(owner: $owner, name: $name) {
+        nameWithOwner
+        url
+        stargazerCount
+        forkCount
+        pushedAt
+        isArchived
+        licenseInfo {
+            spdxId
+            name
+        }
+        repositoryTopics(first: 20) {
+            nodes {
+                topic {
+                    name
+                }
+            }
+        }
+    }
+}
+`
+
+// RepositoryMetadata represents the live metadata of a GitHub repository
+type RepositoryMetadata struct {
+	FullName   string     `json:"fullName"`
+	URL        string     `json:"url"`
+	Stars      int        `json:"stars"`
+	Forks      int        `json:"forks"`
+	PushedAt   *time.Time `json:"pushedAt"`
+	License    *string    `json:"license"`
+	IsArchived bool       `json:"isArchived"`
+	Topics     []string   `json:"topics"`
+}
+
+// repositoryResponse is the data payload of a repository query
+type repositoryResponse struct {
+	Repository *struct {
+		NameWithOwner  string     `json:"nameWithOwner"`
+		URL            string     `json:"url"`
+		StargazerCount int        `json:"stargazerCount"`
+		ForkCount      int        `json:"forkCount"`
+		PushedAt       *time.Time `json:"pushedAt"`
+		IsArchived     bool       `json:"isArchived"`
+		LicenseInfo    *struct {
+			SpdxId string `json:"spdxId"`
+			Name   string `json:"name"`
+		} `json:"licenseInfo"`
+		RepositoryTopics struct {
+			Nodes []struct {
+				Topic struct {
+					Name string `json:"name"`
+				} `json:"topic"`
+			} `json:"nodes"`
+		} `json:"repositoryTopics"`
+	} `json:"repository"`
+}
+
+// ParseGitHubRepoURL extracts the owner and repository name from a GitHub
+// repository URL such as https://github.com/owner/repo
+func ParseGitHubRepoURL(rawURL string) (string, string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid GitHub URL: %w", err)
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Host), "www.")
+	if host != "github.com" {
+		return "", "", fmt.Errorf("invalid GitHub URL: host must be github.com")
+	}
+
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid GitHub URL: expected github.com/owner/repository")
+	}
+
+	return parts[0], strings.TrimSuffix(parts[1], ".git"), nil
+}
+
+// GetRepository fetches the live metadata for a GitHub repository. Renamed
+// or transferred repositories resolve to their new location, so callers can
+// compare FullName against the requested owner and name.
+func (s *GitHubService) GetRepository(ctx context.Context, owner, name string) (*RepositoryMetadata, error) {
+	var data repositoryResponse
+	variables := map[string]interface{}{
+		"owner": owner,
+		"name":  name,
+	}
+	if err := s.query(ctx, repositoryQuery, variables, &data); err != nil {
+		return nil, err
+	}
+
+	repo := data.Repository
+	if repo == nil {
+		return nil, fmt.Errorf("%w: repository %s/%s", ErrNotFound, owner, name)
+	}
+
+	metadata := &RepositoryMetadata{
+		FullName:   repo.NameWithOwner,
+		URL:        repo.URL,
+		Stars:      repo.StargazerCount,
+		Forks:      repo.ForkCount,
+		PushedAt:   repo.PushedAt,
+		IsArchived: repo.IsArchived,
+		Topics:     make([]string, 0, len(repo.RepositoryTopics.Nodes)),
+	}
+
+	if repo.LicenseInfo != nil {
+		license := repo.LicenseInfo.SpdxId
+		if license == "" || license == "NOASSERTION" {
+			license = repo.LicenseInfo.Name
+		}
+		metadata.License = &license
+	}
+
+	for _, node := range repo.RepositoryTopics.Nodes {
+		metadata.Topics = append(metadata.Topics, node.Topic.Name)
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ryanmello/devboard/httpclient"
+)
+
+const gitlabDefaultURL = "https://gitlab.com"
+
+// gitlabCacheTTL is how long GitLab responses are cached
+const gitlabCacheTTL = time.Hour
+
+// gitlabProjectLimit is the number of public projects returned
+const gitlabProjectLimit = 50
+
+// GitLabProject represents a public project on a GitLab instance
+type GitLabProject struct {
+	Id             int        `json:"id"`
+	Name           string     `json:"name"`
+	FullName       string     `json:"fullName"`
+	Description    *string    `json:"description"`
+	URL            string     `json:"url"`
+	Stars          int        `json:"stars"`
+	Forks          int        `json:"forks"`
+	Topics         []string   `json:"topics"`
+	LastActivityAt *time.Time `json:"lastActivityAt"`
+}
+
+// gitlabProject is a project returned by the GitLab REST API
+type gitlabProject struct {
+	Id                int        `json:"id"`
+	Name              string     `json:"name"`
+	PathWithNamespace string     `json:"path_with_namespace"`
+	Description       *string    `json:"description"`
+	WebURL            string     `json:"web_url"`
+	StarCount         int        `json:"star_count"`
+	ForksCount        int        `json:"forks_count"`
+	Topics            []string   `json:"topics"`
+	LastActivityAt    *time.Time `json:"last_activity_at"`
+}
+
+// GitLabService handles interactions with gitlab.com or a self-hosted GitLab
+// instance
+type GitLabService struct {
+	baseURL string
+	client  *httpclient.Client
+}
+
+// NewGitLabService creates a new GitLab service for the given instance URL.
+// An empty instance URL falls back to GITLAB_URL, then to gitlab.com.
+// Instance URLs come from users, so requests to them are restricted to
+// public addresses.
+func NewGitLabService(instanceURL string) *GitLabService {
+	client := httpclient.Public
+	if instanceURL == "" {
+		instanceURL = os.Getenv("GITLAB_URL")
+		client = httpclient.Default
+	}
+	if instanceURL == "" {
+		instanceURL = gitlabDefaultURL
+	}
+
+	return &GitLabService{
+		baseURL: strings.TrimSuffix(instanceURL, "/"),
+		client:  client,
+	}
+}
+
+// NormalizeGitLabURL validates a GitLab instance URL and strips any path,
+// query or trailing slash. The instance must be served over https from a
+// host that resolves only to public addresses.
+func NormalizeGitLabURL(ctx context.Context, rawURL string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" {
+		return "", fmt.Errorf("invalid GitLab URL: expected an absolute URL such as https://gitlab.example.com")
+	}
+	if parsed.Scheme != "https" {
+		return "", fmt.Errorf("invalid GitLab URL: scheme must be https")
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, parsed.Hostname())
+	if err != nil || len(addrs) == 0 {
+		return "", fmt.Errorf("invalid GitLab URL: host %s could not be resolved", parsed.Hostname())
+	}
+	for _, addr := range addrs {
+		if !httpclient.IsPublicIP(addr.IP) {
+			return "", fmt.Errorf("invalid GitLab URL: host %s is not a public address", parsed.Hostname())
+		}
+	}
+
+	return parsed.Scheme + "://" + parsed.Host, nil
+}
+
+// GetContributions fetches the contribution calendar for a GitLab user over
+// the trailing year, in the same shape as GitHub contribution data
+func (s *GitLabService) GetContributions(ctx context.Context, username string) (*GitHubContributionData, error) {
+	key := fmt.Sprintf("gitlab:contributions:%s:%s", s.baseURL, strings.ToLower(username))
+	if cached, ok := externalCache.get(key); ok {
+		return cached.(*GitHubContributionData), nil
+	}
+
+	// The calendar endpoint backs the profile heatmap and is available
+	// without authentication on every GitLab instance
+	var counts map[string]int
+	if err := s.get(ctx, "/users/"+url.PathEscape(username)+"/calendar.json", &counts); err != nil {
+		return nil, err
+	}
+
+	data := BuildContributionCalendar(counts, time.Now().UTC())
+
+	externalCache.set(key, data, gitlabCacheTTL)
+	return data, nil
+}
+
+// GetProjects fetches the public projects owned by a GitLab user, most
+// recently active first
+func (s *GitLabService) GetProjects(ctx context.Context, username string) ([]GitLabProject, error) {
+	key := fmt.Sprintf("gitlab:projects:%s:%s", s.baseURL, strings.ToLower(username))
+	if cached, ok := externalCache.get(key); ok {
+		return cached.([]GitLabProject), nil
+	}
+
+	params := url.Values{
+		"visibility": {"public"},
+		"order_by":   {"last_activity_at"},
+		"per_page":   {fmt.Sprint(gitlabProjectLimit)},
+	}
+
+	var raw []gitlabProject
+	if err := s.get(ctx, "/api/v4/users/"+url.PathEscape(username)+"/projects?"+params.Encode(), &raw); err != nil {
+		return nil, err
+	}
+
+	projects := make([]GitLabProject, 0, len(raw))
+	for _, p := range raw {
+		topics := p.Topics
+		if topics == nil {
+			topics = []string{}
+		}
+		projects = append(projects, GitLabProject{
+			Id:             p.Id,
+			Name:           p.Name,
+			FullName:       p.PathWithNamespace,
+			Description:    p.Description,
+			URL:            p.WebURL,
+			Stars:          p.StarCount,
+			Forks:          p.ForksCount,
+			Topics:         topics,
+			LastActivityAt: p.LastActivityAt,
+		})
+	}
+
+	externalCache.set(key, projects, gitlabCacheTTL)
+	return projects, nil
+}
+
+// get fetches a path on the GitLab instance and decodes the JSON response
+// into out
+func (s *GitLabService) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", s.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// Each instance gets its own circuit breaker so a failing self-hosted
+	// GitLab does not take gitlab.com down with it
+	upstream := "GitLab " + req.URL.Host
+
+	resp, err := s.client.Do(ctx, upstream, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: GitLab returned status 404 for %s", ErrNotFound, path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return httpclient.BadResponse(upstream, resp.StatusCode, nil)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return httpclient.BadResponse(upstream, resp.StatusCode, err)
+	}
+
+	return nil
+}
@@ -1,63 +1,371 @@
 package services
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"os"
+
+	"github.com/ryanmello/devboard/httpclient"
 )
 
-const leetcodeAPIEndpoint = "https://leetcode-stats-api.herokuapp.com"
+const leetcodeGraphQLEndpoint = "https://leetcode.com/graphql"
+
+// leetcodeUpstream names the LeetCode API for the outbound client
+const leetcodeUpstream = "LeetCode"
+
+// recentSubmissionLimit is the number of recent accepted submissions returned
+const recentSubmissionLimit = 15
+
+const leetcodeProfileQuery = `
+query($username: String!, $limit: Int!) {
+    allQuestionsCount {
+        difficulty
+        count
+    }
+    matchedUser(username: $username) {
+        contributions {
+            points
+        }
+        profile {
+            ranking
+            reputation
+        }
+        submissionCalendar
+        submitStatsGlobal {
+            acSubmissionNum {
+                difficulty
+                count
+                submissions
+            }
+            totalSubmissionNum {
+                difficulty
+                count
+                submissions
+            }
+        }
+        languageProblemCount {
+            languageName
+            problemsSolved
+        }
+        tagProblemCounts {
+            advanced {
+                tagName
+                tagSlug
+                problemsSolved
+            }
+            intermediate {
+                tagName
+                tagSlug
+                problemsSolved
+            }
+            fundamental {
+                tagName
+                tagSlug
+                problemsSolved
+            }
+        }
+    }
+    recentAcSubmissionList(username: $username, limit: $limit) {
+        id
+        title
+        titleSlug
+        timestamp
+        lang
+    }
+}
+`
 
 // LeetCodeStats represents the statistics for a LeetCode user
 type LeetCodeStats struct {
-	Status             string         `json:"status"`
-	Message            string         `json:"message,omitempty"`
-	TotalSolved        int            `json:"totalSolved"`
-	TotalQuestions     int            `json:"totalQuestions"`
-	EasySolved         int            `json:"easySolved"`
-	TotalEasy          int            `json:"totalEasy"`
-	MediumSolved       int            `json:"mediumSolved"`
-	TotalMedium        int            `json:"totalMedium"`
-	HardSolved         int            `json:"hardSolved"`
-	TotalHard          int            `json:"totalHard"`
-	AcceptanceRate     float64        `json:"acceptanceRate"`
-	Ranking            int            `json:"ranking"`
-	ContributionPoints int            `json:"contributionPoints"`
-	Reputation         int            `json:"reputation"`
-	SubmissionCalendar map[string]int `json:"submissionCalendar"`
+	Status             string               `json:"status"`
+	Message            string               `json:"message,omitempty"`
+	TotalSolved        int                  `json:"totalSolved"`
+	TotalQuestions     int                  `json:"totalQuestions"`
+	EasySolved         int                  `json:"easySolved"`
+	TotalEasy          int                  `json:"totalEasy"`
+	MediumSolved       int                  `json:"mediumSolved"`
+	TotalMedium        int                  `json:"totalMedium"`
+	HardSolved         int                  `json:"hardSolved"`
+	TotalHard          int                  `json:"totalHard"`
+	AcceptanceRate     float64              `json:"acceptanceRate"`
+	Ranking            int                  `json:"ranking"`
+	ContributionPoints int                  `json:"contributionPoints"`
+	Reputation         int                  `json:"reputation"`
+	SubmissionCalendar map[string]int       `json:"submissionCalendar"`
+	RecentSubmissions  []LeetCodeSubmission `json:"recentSubmissions"`
+	Languages          []LeetCodeLanguage   `json:"languages"`
+	Tags               []LeetCodeTag        `json:"tags"`
+}
+
+// LeetCodeSubmission represents a recent accepted submission
+type LeetCodeSubmission struct {
+	Id        string `json:"id"`
+	Title     string `json:"title"`
+	TitleSlug string `json:"titleSlug"`
+	Timestamp int64  `json:"timestamp"`
+	Language  string `json:"language"`
+}
+
+// LeetCodeLanguage represents the number of problems solved in a language
+type LeetCodeLanguage struct {
+	Language       string `json:"language"`
+	ProblemsSolved int    `json:"problemsSolved"`
+}
+
+// LeetCodeTag represents the number of problems solved for a topic tag
+type LeetCodeTag struct {
+	Name           string `json:"name"`
+	Slug           string `json:"slug"`
+	Level          string `json:"level"`
+	ProblemsSolved int    `json:"problemsSolved"`
+}
+
+// leetcodeDifficultyCount is a per-difficulty count returned by LeetCode
+type leetcodeDifficultyCount struct {
+	Difficulty  string `json:"difficulty"`
+	Count       int    `json:"count"`
+	Submissions int    `json:"submissions"`
+}
+
+// leetcodeTagCount is a per-tag count returned by LeetCode
+type leetcodeTagCount struct {
+	TagName        string `json:"tagName"`
+	TagSlug        string `json:"tagSlug"`
+	ProblemsSolved int    `json:"problemsSolved"`
+}
+
+// leetcodeProfileResponse is the data payload of a profile query
+type leetcodeProfileResponse struct {
+	AllQuestionsCount []leetcodeDifficultyCount `json:"allQuestionsCount"`
+	MatchedUser       *struct {
+		Contributions struct {
+			Points int `json:"points"`
+		} `json:"contributions"`
+		Profile struct {
+			Ranking    int `json:"ranking"`
+			Reputation int `json:"reputation"`
+		} `json:"profile"`
+		SubmissionCalendar string `json:"submissionCalendar"`
+		SubmitStatsGlobal  struct {
+			AcSubmissionNum    []leetcodeDifficultyCount `json:"acSubmissionNum"`
+			TotalSubmissionNum []leetcodeDifficultyCount `json:"totalSubmissionNum"`
+		} `json:"submitStatsGlobal"`
+		LanguageProblemCount []struct {
+			LanguageName   string `json:"languageName"`
+			ProblemsSolved int    `json:"problemsSolved"`
+		} `json:"languageProblemCount"`
+		TagProblemCounts struct {
+			Advanced     []leetcodeTagCount `json:"advanced"`
+			Intermediate []leetcodeTagCount `json:"intermediate"`
+			Fundamental  []leetcodeTagCount `json:"fundamental"`
+		} `json:"tagProblemCounts"`
+	} `json:"matchedUser"`
+	RecentAcSubmissionList []struct {
+		Id        string `json:"id"`
+		Title     string `json:"title"`
+		TitleSlug string `json:"titleSlug"`
+		Timestamp int64  `json:"timestamp,string"`
+		Lang      string `json:"lang"`
+	} `json:"recentAcSubmissionList"`
 }
 
 // LeetCodeService handles LeetCode API interactions
-type LeetCodeService struct{}
+type LeetCodeService struct {
+	endpoint string
+}
 
-// NewLeetCodeService creates a new LeetCode service instance
+// NewLeetCodeService creates a new LeetCode service instance. The GraphQL
+// endpoint can be overridden with LEETCODE_GRAPHQL_URL.
 func NewLeetCodeService() *LeetCodeService {
-	return &LeetCodeService{}
+	endpoint := os.Getenv("LEETCODE_GRAPHQL_URL")
+	if endpoint == "" {
+		endpoint = leetcodeGraphQLEndpoint
+	}
+
+	return &LeetCodeService{
+		endpoint: endpoint,
+	}
 }
 
 // GetStats fetches the statistics for a LeetCode user
-func (s *LeetCodeService) GetStats(username string) (*LeetCodeStats, error) {
-	url := fmt.Sprintf("%s/%s", leetcodeAPIEndpoint, username)
+func (s *LeetCodeService) GetStats(ctx context.Context, username string) (*LeetCodeStats, error) {
+	var data leetcodeProfileResponse
+	variables := map[string]interface{}{
+		"username": username,
+		"limit":    recentSubmissionLimit,
+	}
+	if err := s.query(ctx, leetcodeProfileQuery, variables, &data); err != nil {
+		return nil, err
+	}
+
+	user := data.MatchedUser
+	if user == nil {
+		return nil, fmt.Errorf("%w: LeetCode user %s", ErrNotFound, username)
+	}
+
+	stats := &LeetCodeStats{
+		Status:             "success",
+		Ranking:            user.Profile.Ranking,
+		Reputation:         user.Profile.Reputation,
+		ContributionPoints: user.Contributions.Points,
+		SubmissionCalendar: map[string]int{},
+		RecentSubmissions:  make([]LeetCodeSubmission, 0, len(data.RecentAcSubmissionList)),
+		Languages:          make([]LeetCodeLanguage, 0, len(user.LanguageProblemCount)),
+		Tags:               []LeetCodeTag{},
+	}
+
+	for _, q := range data.AllQuestionsCount {
+		switch q.Difficulty {
+		case "All":
+			stats.TotalQuestions = q.Count
+		case "Easy":
+			stats.TotalEasy = q.Count
+		case "Medium":
+			stats.TotalMedium = q.Count
+		case "Hard":
+			stats.TotalHard = q.Count
+		}
+	}
+
+	for _, ac := range user.SubmitStatsGlobal.AcSubmissionNum {
+		switch ac.Difficulty {
+		case "All":
+			stats.TotalSolved = ac.Count
+		case "Easy":
+			stats.EasySolved = ac.Count
+		case "Medium":
+			stats.MediumSolved = ac.Count
+		case "Hard":
+			stats.HardSolved = ac.Count
+		}
+	}
+
+	stats.AcceptanceRate = acceptanceRate(
+		user.SubmitStatsGlobal.AcSubmissionNum,
+		user.SubmitStatsGlobal.TotalSubmissionNum,
+	)
+
+	// The calendar is a JSON-encoded object keyed by Unix timestamp
+	if user.SubmissionCalendar != "" {
+		if err := json.Unmarshal([]byte(user.SubmissionCalendar), &stats.SubmissionCalendar); err != nil {
+			return nil, fmt.Errorf("failed to decode submission calendar: %w", err)
+		}
+	}
+
+	for _, sub := range data.RecentAcSubmissionList {
+		stats.RecentSubmissions = append(stats.RecentSubmissions, LeetCodeSubmission{
+			Id:        sub.Id,
+			Title:     sub.Title,
+			TitleSlug: sub.TitleSlug,
+			Timestamp: sub.Timestamp,
+			Language:  sub.Lang,
+		})
+	}
+
+	for _, lang := range user.LanguageProblemCount {
+		stats.Languages = append(stats.Languages, LeetCodeLanguage{
+			Language:       lang.LanguageName,
+			ProblemsSolved: lang.ProblemsSolved,
+		})
+	}
+
+	levels := []struct {
+		name string
+		tags []leetcodeTagCount
+	}{
+		{"fundamental", user.TagProblemCounts.Fundamental},
+		{"intermediate", user.TagProblemCounts.Intermediate},
+		{"advanced", user.TagProblemCounts.Advanced},
+	}
+	for _, level := range levels {
+		for _, tag := range level.tags {
+			stats.Tags = append(stats.Tags, LeetCodeTag{
+				Name:           tag.TagName,
+				Slug:           tag.TagSlug,
+				Level:          level.name,
+				ProblemsSolved: tag.ProblemsSolved,
+			})
+		}
+	}
+
+	return stats, nil
+}
+
+// acceptanceRate returns the percentage of accepted submissions, rounded to
+// two decimal places
+func acceptanceRate(accepted, total []leetcodeDifficultyCount) float64 {
+	var acceptedCount, totalCount int
+	for _, ac := range accepted {
+		if ac.Difficulty == "All" {
+			acceptedCount = ac.Submissions
+		}
+	}
+	for _, t := range total {
+		if t.Difficulty == "All" {
+			totalCount = t.Submissions
+		}
+	}
+
+	if totalCount == 0 {
+		return 0
+	}
+	return math.Round(float64(acceptedCount)/float64(totalCount)*10000) / 100
+}
 
-	resp, err := http.Get(url)
+// query executes a GraphQL query against the LeetCode API and decodes the
+// data payload into out
+func (s *LeetCodeService) query(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	reqBody := graphqlRequest{
+		Query:     query,
+		Variables: variables,
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch LeetCode stats: %w", err)
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.endpoint, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// LeetCode rejects GraphQL requests that do not look like they come
+	// from its own site
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Referer", "https://leetcode.com")
+
+	resp, err := httpclient.Default.Do(ctx, leetcodeUpstream, req)
+	if err != nil {
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("LeetCode API returned status %d", resp.StatusCode)
+		return httpclient.BadResponse(leetcodeUpstream, resp.StatusCode, nil)
+	}
+
+	var result graphqlResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return httpclient.BadResponse(leetcodeUpstream, resp.StatusCode, err)
 	}
 
-	var stats LeetCodeStats
-	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if len(result.Data) > 0 && string(result.Data) != "null" {
+		if err := json.Unmarshal(result.Data, out); err != nil {
+			return httpclient.BadResponse(leetcodeUpstream, resp.StatusCode, err)
+		}
 	}
 
-	// Check if the API returned an error status
-	if stats.Status == "error" {
-		return nil, fmt.Errorf("LeetCode API error: %s", stats.Message)
+	// LeetCode reports unknown users as an error alongside a null
+	// matchedUser, which callers turn into ErrNotFound
+	if len(result.Errors) > 0 && (len(result.Data) == 0 || string(result.Data) == "null") {
+		return httpclient.BadResponse(leetcodeUpstream, resp.StatusCode,
+			fmt.Errorf("LeetCode API error: %s", result.Errors[0].Message))
 	}
 
-	return &stats, nil
+	return nil
 }
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// contestsCacheTTL is how long contest data is cached; ratings only change
+// after weekly and biweekly contests
+const contestsCacheTTL = time.Hour
+
+const leetcodeContestQuery = `
+query($username: String!) {
+    matchedUser(username: $username) {
+        badges {
+            id
+            name
+            displayName
+            icon
+            creationDate
+        }
+    }
+    userContestRanking(username: $username) {
+        attendedContestsCount
+        rating
+        globalRanking
+        totalParticipants
+        topPercentage
+        badge {
+            name
+        }
+    }
+    userContestRankingHistory(username: $username) {
+        attended
+        rating
+        ranking
+        trendDirection
+        problemsSolved
+        totalProblems
+        finishTimeInSeconds
+        contest {
+            title
+            startTime
+        }
+    }
+}
+`
+
+// LeetCodeContestStats represents a LeetCode user's contest performance and badges
+type LeetCodeContestStats struct {
+	Rating                float64                 `json:"rating"`
+	GlobalRanking         int                     `json:"globalRanking"`
+	TotalParticipants     int                     `json:"totalParticipants"`
+	TopPercentage         float64                 `json:"topPercentage"`
+	AttendedContestsCount int                     `json:"attendedContestsCount"`
+	ContestBadge          *string                 `json:"contestBadge"`
+	History               []LeetCodeContestResult `json:"history"`
+	Badges                []LeetCodeBadge         `json:"badges"`
+}
+
+// LeetCodeContestResult represents the user's result in a single contest
+type LeetCodeContestResult struct {
+	Title               string  `json:"title"`
+	StartTime           int64   `json:"startTime"`
+	Rating              float64 `json:"rating"`
+	Ranking             int     `json:"ranking"`
+	TrendDirection      string  `json:"trendDirection"`
+	ProblemsSolved      int     `json:"problemsSolved"`
+	TotalProblems       int     `json:"totalProblems"`
+	FinishTimeInSeconds int     `json:"finishTimeInSeconds"`
+}
+
+// LeetCodeBadge represents a badge earned on LeetCode
+type LeetCodeBadge struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	DisplayName  string `json:"displayName"`
+	Icon         string `json:"icon"`
+	CreationDate string `json:"creationDate"`
+}
+
+// leetcodeContestResponse is the data payload of a contest query
+type leetcodeContestResponse struct {
+	MatchedUser *struct {
+		Badges []LeetCodeBadge `json:"badges"`
+	} `json:"matchedUser"`
+	UserContestRanking *struct {
+		AttendedContestsCount int     `json:"attendedContestsCount"`
+		Rating                float64 `json:"rating"`
+		GlobalRanking         int     `json:"globalRanking"`
+		TotalParticipants     int     `json:"totalParticipants"`
+		TopPercentage         float64 `json:"topPercentage"`
+		Badge                 *struct {
+			Name string `json:"name"`
+		} `json:"badge"`
+	} `json:"userContestRanking"`
+	UserContestRankingHistory []struct {
+		Attended            bool    `json:"attended"`
+		Rating              float64 `json:"rating"`
+		Ranking             int     `json:"ranking"`
+		TrendDirection      string  `json:"trendDirection"`
+		ProblemsSolved      int     `json:"problemsSolved"`
+		TotalProblems       int     `json:"totalProblems"`
+		FinishTimeInSeconds int     `json:"finishTimeInSeconds"`
+		Contest             struct {
+			Title     string `json:"title"`
+			StartTime int64  `json:"startTime"`
+		} `json:"contest"`
+	} `json:"userContestRankingHistory"`
+}
+
+// GetContests fetches contest rating, rating history and badges for a
+// LeetCode user. Users who have never entered a contest get an empty history.
+func (s *LeetCodeService) GetContests(ctx context.Context, username string) (*LeetCodeContestStats, error) {
+	key := "leetcode:contests:" + strings.ToLower(username)
+	if cached, ok := externalCache.get(key); ok {
+		return cached.(*LeetCodeContestStats), nil
+	}
+
+	var data leetcodeContestResponse
+	if err := s.query(ctx, leetcodeContestQuery, map[string]interface{}{"username": username}, &data); err != nil {
+		return nil, err
+	}
+
+	if data.MatchedUser == nil {
+		return nil, fmt.Errorf("%w: LeetCode user %s", ErrNotFound, username)
+	}
+
+	stats := &LeetCodeContestStats{
+		History: []LeetCodeContestResult{},
+		Badges:  make([]LeetCodeBadge, 0, len(data.MatchedUser.Badges)),
+	}
+
+	if ranking := data.UserContestRanking; ranking != nil {
+		stats.Rating = ranking.Rating
+		stats.GlobalRanking = ranking.GlobalRanking
+		stats.TotalParticipants = ranking.TotalParticipants
+		stats.TopPercentage = ranking.TopPercentage
+		stats.AttendedContestsCount = ranking.AttendedContestsCount
+		if ranking.Badge != nil {
+			stats.ContestBadge = &ranking.Badge.Name
+		}
+	}
+
+	// The history lists every contest held since the user joined; only the
+	// ones they took part in are meaningful
+	for _, entry := range data.UserContestRankingHistory {
+		if !entry.Attended {
+			continue
+		}
+		stats.History = append(stats.History, LeetCodeContestResult{
+			Title:               entry.Contest.Title,
+			StartTime:           entry.Contest.StartTime,
+			Rating:              entry.Rating,
+			Ranking:             entry.Ranking,
+			TrendDirection:      entry.TrendDirection,
+			ProblemsSolved:      entry.ProblemsSolved,
+			TotalProblems:       entry.TotalProblems,
+			FinishTimeInSeconds: entry.FinishTimeInSeconds,
+		})
+	}
+
+	for _, badge := range data.MatchedUser.Badges {
+		if strings.HasPrefix(badge.Icon, "/") {
+			badge.Icon = "https://leetcode.com" + badge.Icon
+		}
+		stats.Badges = append(stats.Badges, badge)
+	}
+
+	externalCache.set(key, stats, contestsCacheTTL)
+	return stats, nil
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const leetcodeProfileFixture = `{
+	"data": {
+		"allQuestionsCount": [
+			{"difficulty": "All", "count": 3000},
+			{"difficulty": "Easy", "count": 800},
+			{"difficulty": "Medium", "count": 1600},
+			{"difficulty": "Hard", "count": 600}
+		],
+		"matchedUser": {
+			"contributions": {"points": 120},
+			"profile": {"ranking": 54321, "reputation": 7},
+			"submissionCalendar": "{\"1700000000\": 3, \"1700086400\": 1}",
+			"submitStatsGlobal": {
+				"acSubmissionNum": [
+					{"difficulty": "All", "count": 250, "submissions": 300},
+					{"difficulty": "Easy", "count": 120, "submissions": 140},
+					{"difficulty": "Medium", "count": 100, "submissions": 130},
+					{"difficulty": "Hard", "count": 30, "submissions": 30}
+				],
+				"totalSubmissionNum": [
+					{"difficulty": "All", "count": 260, "submissions": 450}
+				]
+			},
+			"languageProblemCount": [
+				{"languageName": "Go", "problemsSolved": 200},
+				{"languageName": "Python3", "problemsSolved": 50}
+			],
+			"tagProblemCounts": {
+				"advanced": [
+					{"tagName": "Dynamic Programming", "tagSlug": "dynamic-programming", "problemsSolved": 40}
+				],
+				"intermediate": [
+					{"tagName": "Hash Table", "tagSlug": "hash-table", "problemsSolved": 70}
+				],
+				"fundamental": [
+					{"tagName": "Array", "tagSlug": "array", "problemsSolved": 150}
+				]
+			}
+		},
+		"recentAcSubmissionList": [
+			{"id": "101", "title": "Two Sum", "titleSlug": "two-sum", "timestamp": "1700090000", "lang": "golang"},
+			{"id": "100", "title": "LRU Cache", "titleSlug": "lru-cache", "timestamp": "1700000000", "lang": "python3"}
+		]
+	}
+}`
+
+const leetcodeUnknownUserFixture = `{
+	"data": {"allQuestionsCount": [], "matchedUser": null, "recentAcSubmissionList": null},
+	"errors": [{"message": "That user does not exist."}]
+}`
+
+// fakeLeetCode serves body for every GraphQL request and points the LeetCode
+// service at it
+func fakeLeetCode(t *testing.T, body string) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if got := req.Variables["username"]; got != "alice" {
+			t.Errorf("username variable = %v, want alice", got)
+		}
+		if got := req.Variables["limit"]; got != float64(recentSubmissionLimit) {
+			t.Errorf("limit variable = %v, want %d", got, recentSubmissionLimit)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("LEETCODE_GRAPHQL_URL", server.URL)
+}
+
+func TestLeetCodeGetStats(t *testing.T) {
+	fakeLeetCode(t, leetcodeProfileFixture)
+
+	stats, err := NewLeetCodeService().GetStats(context.Background(), "alice")
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+
+	counts := []struct {
+		name      string
+		got, want int
+	}{
+		{"TotalSolved", stats.TotalSolved, 250},
+		{"TotalQuestions", stats.TotalQuestions, 3000},
+		{"EasySolved", stats.EasySolved, 120},
+		{"TotalEasy", stats.TotalEasy, 800},
+		{"MediumSolved", stats.MediumSolved, 100},
+		{"TotalMedium", stats.TotalMedium, 1600},
+		{"HardSolved", stats.HardSolved, 30},
+		{"TotalHard", stats.TotalHard, 600},
+		{"Ranking", stats.Ranking, 54321},
+		{"Reputation", stats.Reputation, 7},
+		{"ContributionPoints", stats.ContributionPoints, 120},
+	}
+	for _, c := range counts {
+		if c.got != c.want {
+			t.Errorf("%s = %d, want %d", c.name, c.got, c.want)
+		}
+	}
+
+	if stats.AcceptanceRate != 66.67 {
+		t.Errorf("AcceptanceRate = %v, want 66.67", stats.AcceptanceRate)
+	}
+
+	wantCalendar := map[string]int{"1700000000": 3, "1700086400": 1}
+	if !reflect.DeepEqual(stats.SubmissionCalendar, wantCalendar) {
+		t.Errorf("SubmissionCalendar = %v, want %v", stats.SubmissionCalendar, wantCalendar)
+	}
+
+	wantSubmissions := []LeetCodeSubmission{
+		{Id: "101", Title: "Two Sum", TitleSlug: "two-sum", Timestamp: 1700090000, Language: "golang"},
+		{Id: "100", Title: "LRU Cache", TitleSlug: "lru-cache", Timestamp: 1700000000, Language: "python3"},
+	}
+	if !reflect.DeepEqual(stats.RecentSubmissions, wantSubmissions) {
+		t.Errorf("RecentSubmissions = %+v, want %+v", stats.RecentSubmissions, wantSubmissions)
+	}
+
+	wantLanguages := []LeetCodeLanguage{
+		{Language: "Go", ProblemsSolved: 200},
+		{Language: "Python3", ProblemsSolved: 50},
+	}
+	if !reflect.DeepEqual(stats.Languages, wantLanguages) {
+		t.Errorf("Languages = %+v, want %+v", stats.Languages, wantLanguages)
+	}
+
+	wantTags := []LeetCodeTag{
+		{Name: "Array", Slug: "array", Level: "fundamental", ProblemsSolved: 150},
+		{Name: "Hash Table", Slug: "hash-table", Level: "intermediate", ProblemsSolved: 70},
+		{Name: "Dynamic Programming", Slug: "dynamic-programming", Level: "advanced", ProblemsSolved: 40},
+	}
+	if !reflect.DeepEqual(stats.Tags, wantTags) {
+		t.Errorf("Tags = %+v, want %+v", stats.Tags, wantTags)
+	}
+}
+
+func TestLeetCodeGetStatsUnknownUser(t *testing.T) {
+	fakeLeetCode(t, leetcodeUnknownUserFixture)
+
+	_, err := NewLeetCodeService().GetStats(context.Background(), "alice")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("GetStats() error = %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// linkedinMaxFileSize caps how much of each CSV in an export is read, which
+// also guards against zip bombs
+const linkedinMaxFileSize = 5 << 20
+
+// LinkedInExport holds the parts of a LinkedIn data export used for profile
+// import
+type LinkedInExport struct {
+	Profile   LinkedInProfile
+	Positions []LinkedInPosition
+	Education []LinkedInEducation
+	Skills    []string
+}
+
+// LinkedInProfile is the single row of Profile.csv
+type LinkedInProfile struct {
+	FirstName string
+	LastName  string
+	Headline  string
+}
+
+// LinkedInPosition is a row of Positions.csv. Months are full month names
+// and are empty when LinkedIn only recorded a year.
+type LinkedInPosition struct {
+	Company     string
+	Title       string
+	Description string
+	Location    string
+	StartMonth  string
+	StartYear   string
+	EndMonth    string
+	EndYear     string
+	IsCurrent   bool
+}
+
+// LinkedInEducation is a row of Education.csv
+type LinkedInEducation struct {
+	School    string
+	Degree    string
+	Field     string
+	StartYear string
+	EndYear   string
+}
+
+// ErrInvalidLinkedInExport is returned when an upload is not a LinkedIn data
+// export
+var ErrInvalidLinkedInExport = errors.New("invalid LinkedIn data export")
+
+// ParseLinkedInExport reads Profile.csv, Positions.csv, Education.csv and
+// Skills.csv from a LinkedIn data export ZIP. Missing files are skipped, but
+// at least one of them must be present.
+func ParseLinkedInExport(r io.ReaderAt, size int64) (*LinkedInExport, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLinkedInExport, err)
+	}
+
+	// Exports are sometimes re-zipped inside a folder, so match on file name
+	files := make(map[string]*zip.File)
+	for _, f := range archive.File {
+		files[strings.ToLower(path.Base(f.Name))] = f
+	}
+
+	export := &LinkedInExport{}
+	found := false
+
+	if rows, ok, err := readLinkedInCSV(files, "profile.csv", "First Name"); err != nil {
+		return nil, err
+	} else if ok {
+		found = true
+		if len(rows) > 0 {
+			export.Profile = LinkedInProfile{
+				FirstName: rows[0]["First Name"],
+				LastName:  rows[0]["Last Name"],
+				Headline:  rows[0]["Headline"],
+			}
+		}
+	}
+
+	if rows, ok, err := readLinkedInCSV(files, "positions.csv", "Company Name"); err != nil {
+		return nil, err
+	} else if ok {
+		found = true
+		for _, row := range rows {
+			if row["Company Name"] == "" || row["Title"] == "" {
+				continue
+			}
+			startMonth, startYear := parseLinkedInDate(row["Started On"])
+			endMonth, endYear := parseLinkedInDate(row["Finished On"])
+			export.Positions = append(export.Positions, LinkedInPosition{
+				Company:     row["Company Name"],
+				Title:       row["Title"],
+				Description: row["Description"],
+				Location:    row["Location"],
+				StartMonth:  startMonth,
+				StartYear:   startYear,
+				EndMonth:    endMonth,
+				EndYear:     endYear,
+				IsCurrent:   row["Finished On"] == "",
+			})
+		}
+	}
+
+	if rows, ok, err := readLinkedInCSV(files, "education.csv", "School Name"); err != nil {
+		return nil, err
+	} else if ok {
+		found = true
+		for _, row := range rows {
+			if row["School Name"] == "" {
+				continue
+			}
+			_, startYear := parseLinkedInDate(row["Start Date"])
+			_, endYear := parseLinkedInDate(row["End Date"])
+			field := row["Fields of Study"]
+			if field == "" {
+				field = row["Field Of Study"]
+			}
+			export.Education = append(export.Education, LinkedInEducation{
+				School:    row["School Name"],
+				Degree:    row["Degree Name"],
+				Field:     field,
+				StartYear: startYear,
+				EndYear:   endYear,
+			})
+		}
+	}
+
+	if rows, ok, err := readLinkedInCSV(files, "skills.csv", "Name"); err != nil {
+		return nil, err
+	} else if ok {
+		found = true
+		for _, row := range rows {
+			if row["Name"] != "" {
+				export.Skills = append(export.Skills, row["Name"])
+			}
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: no Profile, Positions, Education or Skills file found", ErrInvalidLinkedInExport)
+	}
+
+	return export, nil
+}
+
+// readLinkedInCSV reads a CSV file from the export into rows keyed by column
+// name. LinkedIn sometimes puts notes above the header, so the header is the
+// first line containing headerColumn. ok is false if the file is missing.
+func readLinkedInCSV(files map[string]*zip.File, name, headerColumn string) (rows []map[string]string, ok bool, err error) {
+	f, exists := files[name]
+	if !exists {
+		return nil, false, nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: failed to open %s: %v", ErrInvalidLinkedInExport, f.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, linkedinMaxFileSize+1))
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: failed to read %s: %v", ErrInvalidLinkedInExport, f.Name, err)
+	}
+	if len(data) > linkedinMaxFileSize {
+		return nil, false, fmt.Errorf("%w: %s is too large", ErrInvalidLinkedInExport, f.Name)
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: failed to parse %s: %v", ErrInvalidLinkedInExport, f.Name, err)
+	}
+
+	var header []string
+	for _, record := range records {
+		if header == nil {
+			for _, column := range record {
+				if strings.TrimSpace(column) == headerColumn {
+					header = record
+					break
+				}
+			}
+			continue
+		}
+
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			if i < len(record) {
+				row[strings.TrimSpace(column)] = strings.TrimSpace(record[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, true, nil
+}
+
+// parseLinkedInDate splits an export date such as "Jan 2020" or "2020" into
+// a full month name and a year. Unrecognised values yield empty strings.
+func parseLinkedInDate(value string) (month, year string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", ""
+	}
+
+	for _, layout := range []string{"Jan 2006", "January 2006", "01/2006"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Month().String(), t.Format("2006")
+		}
+	}
+	if t, err := time.Parse("2006", value); err == nil {
+		return "", t.Format("2006")
+	}
+
+	return "", ""
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/ryanmello/devboard/db"
+	"github.com/ryanmello/devboard/httpclient"
+)
+
+// projectSyncBatchSize limits how many projects are refreshed per sync run
+const projectSyncBatchSize = 50
+
+// SyncProjectRepository resolves a project's GitHub URL and stores the
+// repository metadata on the project. Repositories that no longer exist or
+// have been renamed are flagged through the repository status.
+func SyncProjectRepository(ctx context.Context, project *db.Project) error {
+	if project.GitHubURL == nil || *project.GitHubURL == "" {
+		return errors.New("project does not have a GitHub URL")
+	}
+
+	now := time.Now()
+
+	owner, name, err := ParseGitHubRepoURL(*project.GitHubURL)
+	if err != nil {
+		if updateErr := updateRepository(project, map[string]interface{}{
+			"repo_status":    db.RepoStatusInvalidURL,
+			"repo_synced_at": now,
+		}); updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+
+	metadata, err := NewGitHubService().GetRepository(ctx, owner, name)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			// Keep the last known metadata so the profile still has something
+			// to show, but flag the repository as gone
+			return updateRepository(project, map[string]interface{}{
+				"repo_status":    db.RepoStatusNotFound,
+				"repo_synced_at": now,
+			})
+		}
+		return err
+	}
+
+	status := db.RepoStatusOK
+	if !strings.EqualFold(metadata.FullName, owner+"/"+name) {
+		status = db.RepoStatusRenamed
+	}
+
+	return updateRepository(project, map[string]interface{}{
+		"repo_full_name":   metadata.FullName,
+		"repo_stars":       metadata.Stars,
+		"repo_forks":       metadata.Forks,
+		"repo_pushed_at":   metadata.PushedAt,
+		"repo_license":     metadata.License,
+		"repo_is_archived": metadata.IsArchived,
+		"repo_topics":      pq.StringArray(metadata.Topics),
+		"repo_status":      status,
+		"repo_synced_at":   now,
+	})
+}
+
+// StartProjectSync periodically refreshes the repository metadata of projects
+// that have never been synced or whose metadata is older than staleAfter
+func StartProjectSync(interval, staleAfter time.Duration) {
+	if NewGitHubService().token == "" {
+		log.Println("GITHUB_TOKEN is not set, project repository sync disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			syncStaleProjects(staleAfter)
+			<-ticker.C
+		}
+	}()
+}
+
+// syncStaleProjects refreshes a batch of projects with missing or stale
+// repository metadata
+func syncStaleProjects(staleAfter time.Duration) {
+	var projects []db.Project
+	err := db.GetDB().
+		Where("git_hub_url IS NOT NULL AND git_hub_url <> ''").
+		Where("repo_synced_at IS NULL OR repo_synced_at < ?", time.Now().Add(-staleAfter)).
+		Order("repo_synced_at ASC NULLS FIRST").
+		Limit(projectSyncBatchSize).
+		Find(&projects).Error
+	if err != nil {
+		log.Printf("Failed to load projects for repository sync: %v", err)
+		return
+	}
+
+	for i := range projects {
+		err := SyncProjectRepository(context.Background(), &projects[i])
+		if errors.Is(err, httpclient.ErrCircuitOpen) {
+			// GitHub is failing; leave the rest of the batch for the next run
+			log.Printf("Stopping repository sync: %v", err)
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to sync repository for project %s: %v", projects[i].Id, err)
+		}
+	}
+}
+
+// updateRepository writes repository columns to the project and reloads it
+func updateRepository(project *db.Project, updates map[string]interface{}) error {
+	if err := db.GetDB().Model(project).Updates(updates).Error; err != nil {
+		return err
+	}
+	return db.GetDB().Where("id = ?", project.Id).First(project).Error
+}
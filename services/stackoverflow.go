@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ryanmello/devboard/httpclient"
+)
+
+const stackExchangeAPIEndpoint = "https://api.stackexchange.com/2.3"
+
+// stackExchangeUpstream names the Stack Exchange API for the outbound client
+const stackExchangeUpstream = "Stack Exchange"
+
+// stackOverflowCacheTTL is how long Stack Overflow responses are cached
+const stackOverflowCacheTTL = time.Hour
+
+const (
+	stackOverflowTopTagLimit    = 10
+	stackOverflowTopAnswerLimit = 5
+)
+
+// StackOverflowStats represents a Stack Overflow user's reputation and top work
+type StackOverflowStats struct {
+	UserId       int                   `json:"userId"`
+	DisplayName  string                `json:"displayName"`
+	ProfileImage string                `json:"profileImage"`
+	Link         string                `json:"link"`
+	Reputation   int                   `json:"reputation"`
+	Badges       StackOverflowBadges   `json:"badges"`
+	TopTags      []StackOverflowTag    `json:"topTags"`
+	TopAnswers   []StackOverflowAnswer `json:"topAnswers"`
+}
+
+// StackOverflowBadges holds a user's badge counts
+type StackOverflowBadges struct {
+	Gold   int `json:"gold"`
+	Silver int `json:"silver"`
+	Bronze int `json:"bronze"`
+}
+
+// StackOverflowTag represents a tag the user has answered questions in
+type StackOverflowTag struct {
+	Name        string `json:"name"`
+	AnswerScore int    `json:"answerScore"`
+	AnswerCount int    `json:"answerCount"`
+}
+
+// StackOverflowAnswer represents one of the user's highest-voted answers
+type StackOverflowAnswer struct {
+	AnswerId   int    `json:"answerId"`
+	QuestionId int    `json:"questionId"`
+	Title      string `json:"title"`
+	Score      int    `json:"score"`
+	IsAccepted bool   `json:"isAccepted"`
+	Link       string `json:"link"`
+	CreatedAt  int64  `json:"createdAt"`
+}
+
+// stackExchangeResponse is the wrapper returned by every Stack Exchange API call
+type stackExchangeResponse struct {
+	Items        json.RawMessage `json:"items"`
+	ErrorId      int             `json:"error_id"`
+	ErrorName    string          `json:"error_name"`
+	ErrorMessage string          `json:"error_message"`
+}
+
+// stackExchangeUser is a user returned by /users/{ids}
+type stackExchangeUser struct {
+	UserId       int    `json:"user_id"`
+	DisplayName  string `json:"display_name"`
+	ProfileImage string `json:"profile_image"`
+	Link         string `json:"link"`
+	Reputation   int    `json:"reputation"`
+	BadgeCounts  struct {
+		Gold   int `json:"gold"`
+		Silver int `json:"silver"`
+		Bronze int `json:"bronze"`
+	} `json:"badge_counts"`
+}
+
+// stackExchangeTag is a tag returned by /users/{id}/top-answer-tags
+type stackExchangeTag struct {
+	TagName     string `json:"tag_name"`
+	AnswerScore int    `json:"answer_score"`
+	AnswerCount int    `json:"answer_count"`
+}
+
+// stackExchangeAnswer is an answer returned by /users/{id}/answers
+type stackExchangeAnswer struct {
+	AnswerId     int   `json:"answer_id"`
+	QuestionId   int   `json:"question_id"`
+	Score        int   `json:"score"`
+	IsAccepted   bool  `json:"is_accepted"`
+	CreationDate int64 `json:"creation_date"`
+}
+
+// stackExchangeQuestion is a question returned by /questions/{ids}
+type stackExchangeQuestion struct {
+	QuestionId int    `json:"question_id"`
+	Title      string `json:"title"`
+}
+
+// StackOverflowService handles Stack Exchange API interactions for Stack Overflow
+type StackOverflowService struct {
+	endpoint string
+	key      string
+}
+
+// NewStackOverflowService creates a new Stack Overflow service instance. The
+// API base URL can be overridden with STACKEXCHANGE_API_URL, and an optional
+// STACKEXCHANGE_KEY raises the request quota.
+func NewStackOverflowService() *StackOverflowService {
+	endpoint := os.Getenv("STACKEXCHANGE_API_URL")
+	if endpoint == "" {
+		endpoint = stackExchangeAPIEndpoint
+	}
+
+	return &StackOverflowService{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		key:      os.Getenv("STACKEXCHANGE_KEY"),
+	}
+}
+
+// GetStats fetches reputation, badge counts, top tags and top answers for a
+// Stack Overflow user
+func (s *StackOverflowService) GetStats(ctx context.Context, userId string) (*StackOverflowStats, error) {
+	key := "stackoverflow:stats:" + userId
+	if cached, ok := externalCache.get(key); ok {
+		return cached.(*StackOverflowStats), nil
+	}
+
+	var users []stackExchangeUser
+	if err := s.get(ctx, "/users/"+url.PathEscape(userId), nil, &users); err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("%w: Stack Overflow user %s", ErrNotFound, userId)
+	}
+	user := users[0]
+
+	var tags []stackExchangeTag
+	tagParams := url.Values{"pagesize": {fmt.Sprint(stackOverflowTopTagLimit)}}
+	if err := s.get(ctx, "/users/"+url.PathEscape(userId)+"/top-answer-tags", tagParams, &tags); err != nil {
+		return nil, err
+	}
+
+	var answers []stackExchangeAnswer
+	answerParams := url.Values{
+		"sort":     {"votes"},
+		"order":    {"desc"},
+		"pagesize": {fmt.Sprint(stackOverflowTopAnswerLimit)},
+	}
+	if err := s.get(ctx, "/users/"+url.PathEscape(userId)+"/answers", answerParams, &answers); err != nil {
+		return nil, err
+	}
+
+	titles, err := s.getQuestionTitles(ctx, answers)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &StackOverflowStats{
+		UserId:       user.UserId,
+		DisplayName:  html.UnescapeString(user.DisplayName),
+		ProfileImage: user.ProfileImage,
+		Link:         user.Link,
+		Reputation:   user.Reputation,
+		Badges: StackOverflowBadges{
+			Gold:   user.BadgeCounts.Gold,
+			Silver: user.BadgeCounts.Silver,
+			Bronze: user.BadgeCounts.Bronze,
+		},
+		TopTags:    make([]StackOverflowTag, 0, len(tags)),
+		TopAnswers: make([]StackOverflowAnswer, 0, len(answers)),
+	}
+
+	for _, tag := range tags {
+		stats.TopTags = append(stats.TopTags, StackOverflowTag{
+			Name:        tag.TagName,
+			AnswerScore: tag.AnswerScore,
+			AnswerCount: tag.AnswerCount,
+		})
+	}
+
+	for _, answer := range answers {
+		stats.TopAnswers = append(stats.TopAnswers, StackOverflowAnswer{
+			AnswerId:   answer.AnswerId,
+			QuestionId: answer.QuestionId,
+			Title:      titles[answer.QuestionId],
+			Score:      answer.Score,
+			IsAccepted: answer.IsAccepted,
+			Link:       fmt.Sprintf("https://stackoverflow.com/a/%d", answer.AnswerId),
+			CreatedAt:  answer.CreationDate,
+		})
+	}
+
+	externalCache.set(key, stats, stackOverflowCacheTTL)
+	return stats, nil
+}
+
+// getQuestionTitles fetches the titles of the questions the given answers
+// belong to, keyed by question id
+func (s *StackOverflowService) getQuestionTitles(ctx context.Context, answers []stackExchangeAnswer) (map[int]string, error) {
+	titles := make(map[int]string, len(answers))
+	if len(answers) == 0 {
+		return titles, nil
+	}
+
+	ids := make([]string, 0, len(answers))
+	for _, answer := range answers {
+		ids = append(ids, fmt.Sprint(answer.QuestionId))
+	}
+
+	var questions []stackExchangeQuestion
+	if err := s.get(ctx, "/questions/"+strings.Join(ids, ";"), nil, &questions); err != nil {
+		return nil, err
+	}
+
+	// Titles come back HTML-encoded
+	for _, question := range questions {
+		titles[question.QuestionId] = html.UnescapeString(question.Title)
+	}
+
+	return titles, nil
+}
+
+// get calls a Stack Exchange API path for the Stack Overflow site and
+// decodes the returned items into out
+func (s *StackOverflowService) get(ctx context.Context, path string, params url.Values, out interface{}) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("site", "stackoverflow")
+	if s.key != "" {
+		params.Set("key", s.key)
+	}
+
+	// Responses are always gzip-compressed; the default transport asks for
+	// and transparently decompresses gzip
+	req, err := http.NewRequestWithContext(ctx, "GET", s.endpoint+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := httpclient.Default.Do(ctx, stackExchangeUpstream, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Errors come back with a non-200 status and details in the wrapper
+	var result stackExchangeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return httpclient.BadResponse(stackExchangeUpstream, resp.StatusCode, err)
+	}
+
+	if result.ErrorId != 0 {
+		return httpclient.BadResponse(stackExchangeUpstream, resp.StatusCode,
+			fmt.Errorf("Stack Exchange API error: %s (%s)", result.ErrorMessage, result.ErrorName))
+	}
+
+	if err := json.Unmarshal(result.Items, out); err != nil {
+		return httpclient.BadResponse(stackExchangeUpstream, resp.StatusCode, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const githubVerificationQuery = `
+query($userName: String!) {
+    user(login: $userName) {
+        bio
+        gists(first: 10, privacy: PUBLIC, orderBy: {field: UPDATED_AT, direction: DESC}) {
+            nodes {
+                description
+                files {
+                    text
+                }
+            }
+        }
+    }
+}
+`
+
+const leetcodeVerificationQuery = `
+query($username: String!) {
+    matchedUser(username: $username) {
+        profile {
+            aboutMe
+        }
+    }
+}
+`
+
+// githubVerificationResponse is the data payload of a GitHub verification query
+type githubVerificationResponse struct {
+	User *struct {
+		Bio   string `json:"bio"`
+		Gists struct {
+			Nodes []struct {
+				Description string `json:"description"`
+				Files       []struct {
+					Text string `json:"text"`
+				} `json:"files"`
+			} `json:"nodes"`
+		} `json:"gists"`
+	} `json:"user"`
+}
+
+// leetcodeVerificationResponse is the data payload of a LeetCode verification query
+type leetcodeVerificationResponse struct {
+	MatchedUser *struct {
+		Profile struct {
+			AboutMe string `json:"aboutMe"`
+		} `json:"profile"`
+	} `json:"matchedUser"`
+}
+
+// HasVerificationToken reports whether a GitHub user has placed the token in
+// their profile bio or in one of their most recently updated public gists
+func (s *GitHubService) HasVerificationToken(ctx context.Context, username, token string) (bool, error) {
+	var data githubVerificationResponse
+	if err := s.query(ctx, githubVerificationQuery, map[string]interface{}{"userName": username}, &data); err != nil {
+		return false, err
+	}
+
+	if data.User == nil {
+		return false, fmt.Errorf("%w: GitHub user %s", ErrNotFound, username)
+	}
+
+	if strings.Contains(data.User.Bio, token) {
+		return true, nil
+	}
+
+	for _, gist := range data.User.Gists.Nodes {
+		if strings.Contains(gist.Description, token) {
+			return true, nil
+		}
+		for _, file := range gist.Files {
+			if strings.Contains(file.Text, token) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// HasVerificationToken reports whether a LeetCode user has placed the token
+// in their profile summary
+func (s *LeetCodeService) HasVerificationToken(ctx context.Context, username, token string) (bool, error) {
+	var data leetcodeVerificationResponse
+	if err := s.query(ctx, leetcodeVerificationQuery, map[string]interface{}{"username": username}, &data); err != nil {
+		return false, err
+	}
+
+	if data.MatchedUser == nil {
+		return false, fmt.Errorf("%w: LeetCode user %s", ErrNotFound, username)
+	}
+
+	return strings.Contains(data.MatchedUser.Profile.AboutMe, token), nil
+}
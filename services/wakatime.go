@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ryanmello/devboard/httpclient"
+)
+
+const wakatimeAPIEndpoint = "https://wakatime.com/api/v1"
+
+// wakatimeUpstream names the WakaTime API for the outbound client
+const wakatimeUpstream = "WakaTime"
+
+// wakatimeCacheTTL is how long WakaTime stats are cached
+const wakatimeCacheTTL = time.Hour
+
+// wakatimeItemLimit is the number of languages, editors and projects returned
+const wakatimeItemLimit = 10
+
+// WakaTimeStats represents a user's coding time over the last seven days
+type WakaTimeStats struct {
+	Username                  string         `json:"username"`
+	Range                     string         `json:"range" example:"last_7_days"`
+	Start                     *time.Time     `json:"start"`
+	End                       *time.Time     `json:"end"`
+	TotalSeconds              float64        `json:"totalSeconds"`
+	DailyAverageSeconds       float64        `json:"dailyAverageSeconds"`
+	HumanReadableTotal        string         `json:"humanReadableTotal" example:"21 hrs 5 mins"`
+	HumanReadableDailyAverage string         `json:"humanReadableDailyAverage" example:"3 hrs 1 min"`
+	Languages                 []WakaTimeItem `json:"languages"`
+	Editors                   []WakaTimeItem `json:"editors"`
+	Projects                  []WakaTimeItem `json:"projects"`
+	IsUpToDate                bool           `json:"isUpToDate"`
+}
+
+// WakaTimeItem is the time spent in a single language, editor or project
+type WakaTimeItem struct {
+	Name         string  `json:"name" example:"Go"`
+	TotalSeconds float64 `json:"totalSeconds"`
+	Percent      float64 `json:"percent"`
+	Text         string  `json:"text" example:"8 hrs 12 mins"`
+}
+
+// wakatimeUserResponse is the response from /users/current
+type wakatimeUserResponse struct {
+	Data struct {
+		Username    string `json:"username"`
+		DisplayName string `json:"display_name"`
+	} `json:"data"`
+}
+
+// wakatimeStatsResponse is the response from /users/current/stats/{range}
+type wakatimeStatsResponse struct {
+	Data struct {
+		Username                  string         `json:"username"`
+		Range                     string         `json:"range"`
+		Start                     *time.Time     `json:"start"`
+		End                       *time.Time     `json:"end"`
+		TotalSeconds              float64        `json:"total_seconds"`
+		DailyAverage              float64        `json:"daily_average"`
+		HumanReadableTotal        string         `json:"human_readable_total"`
+		HumanReadableDailyAverage string         `json:"human_readable_daily_average"`
+		Languages                 []wakatimeItem `json:"languages"`
+		Editors                   []wakatimeItem `json:"editors"`
+		Projects                  []wakatimeItem `json:"projects"`
+		IsUpToDate                bool           `json:"is_up_to_date"`
+	} `json:"data"`
+}
+
+// wakatimeItem is a language, editor or project entry in a stats response
+type wakatimeItem struct {
+	Name         string  `json:"name"`
+	TotalSeconds float64 `json:"total_seconds"`
+	Percent      float64 `json:"percent"`
+	Text         string  `json:"text"`
+}
+
+// WakaTimeService handles WakaTime API interactions on behalf of a single
+// account
+type WakaTimeService struct {
+	endpoint string
+	apiKey   string
+}
+
+// NewWakaTimeService creates a WakaTime service authenticated with the given
+// API key. The API base URL can be overridden with WAKATIME_API_URL.
+func NewWakaTimeService(apiKey string) *WakaTimeService {
+	endpoint := os.Getenv("WAKATIME_API_URL")
+	if endpoint == "" {
+		endpoint = wakatimeAPIEndpoint
+	}
+
+	return &WakaTimeService{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		apiKey:   apiKey,
+	}
+}
+
+// GetUsername returns the username of the account the API key belongs to.
+// It doubles as a check that the key is valid.
+func (s *WakaTimeService) GetUsername(ctx context.Context) (string, error) {
+	var resp wakatimeUserResponse
+	if err := s.get(ctx, "/users/current", &resp); err != nil {
+		return "", err
+	}
+
+	if resp.Data.Username != "" {
+		return resp.Data.Username, nil
+	}
+	return resp.Data.DisplayName, nil
+}
+
+// GetStats fetches coding time, top languages, editors and projects for the
+// last seven days
+func (s *WakaTimeService) GetStats(ctx context.Context) (*WakaTimeStats, error) {
+	// Keyed by a hash so the API key itself is never held in the cache
+	sum := sha256.Sum256([]byte(s.endpoint + "\x00" + s.apiKey))
+	key := "wakatime:stats:" + hex.EncodeToString(sum[:])
+	if cached, ok := externalCache.get(key); ok {
+		return cached.(*WakaTimeStats), nil
+	}
+
+	var resp wakatimeStatsResponse
+	if err := s.get(ctx, "/users/current/stats/last_7_days", &resp); err != nil {
+		return nil, err
+	}
+	data := resp.Data
+
+	stats := &WakaTimeStats{
+		Username:                  data.Username,
+		Range:                     data.Range,
+		Start:                     data.Start,
+		End:                       data.End,
+		TotalSeconds:              data.TotalSeconds,
+		DailyAverageSeconds:       data.DailyAverage,
+		HumanReadableTotal:        data.HumanReadableTotal,
+		HumanReadableDailyAverage: data.HumanReadableDailyAverage,
+		Languages:                 wakatimeItems(data.Languages),
+		Editors:                   wakatimeItems(data.Editors),
+		Projects:                  wakatimeItems(data.Projects),
+		IsUpToDate:                data.IsUpToDate,
+	}
+
+	// Stats still being calculated are returned but not cached, so the
+	// complete numbers show up as soon as WakaTime has them
+	if stats.IsUpToDate {
+		externalCache.set(key, stats, wakatimeCacheTTL)
+	}
+	return stats, nil
+}
+
+// wakatimeItems converts stats entries, keeping the largest ones
+func wakatimeItems(raw []wakatimeItem) []WakaTimeItem {
+	if len(raw) > wakatimeItemLimit {
+		raw = raw[:wakatimeItemLimit]
+	}
+
+	items := make([]WakaTimeItem, 0, len(raw))
+	for _, item := range raw {
+		items = append(items, WakaTimeItem{
+			Name:         item.Name,
+			TotalSeconds: item.TotalSeconds,
+			Percent:      item.Percent,
+			Text:         item.Text,
+		})
+	}
+	return items
+}
+
+// get calls a WakaTime API path and decodes the JSON response into out
+func (s *WakaTimeService) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", s.endpoint+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(s.apiKey)))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpclient.Default.Do(ctx, wakatimeUpstream, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted:
+		// 202 means stats are still being calculated; the body is still usable
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: WakaTime returned status %d", ErrInvalidCredentials, resp.StatusCode)
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: WakaTime returned status 404 for %s", ErrNotFound, path)
+	default:
+		return httpclient.BadResponse(wakatimeUpstream, resp.StatusCode, nil)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return httpclient.BadResponse(wakatimeUpstream, resp.StatusCode, err)
+	}
+
+	return nil
+}
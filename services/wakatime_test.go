@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const wakatimeTestKey = "waka_test_key"
+
+// fakeWakaTime serves stats for the last seven days with the given number of
+// languages and up-to-date flag, and points the WakaTime service at it. It
+// returns the number of stats requests served.
+func fakeWakaTime(t *testing.T, languages int, upToDate bool) *atomic.Int32 {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := "Basic " + base64.StdEncoding.EncodeToString([]byte(wakatimeTestKey))
+		if r.Header.Get("Authorization") != want {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/users/current":
+			fmt.Fprint(w, `{"data": {"username": "alice", "display_name": "Alice"}}`)
+		case "/users/current/stats/last_7_days":
+			calls.Add(1)
+			items := make([]string, 0, languages)
+			for i := 0; i < languages; i++ {
+				items = append(items, fmt.Sprintf(
+					`{"name": "lang%d", "total_seconds": %d, "percent": %d, "text": "%d mins"}`,
+					i, (languages-i)*60, languages-i, languages-i))
+			}
+			fmt.Fprintf(w, `{"data": {
+				"username": "alice",
+				"range": "last_7_days",
+				"start": "2026-01-01T00:00:00Z",
+				"end": "2026-01-07T23:59:59Z",
+				"total_seconds": 75900,
+				"daily_average": 10842.86,
+				"human_readable_total": "21 hrs 5 mins",
+				"human_readable_daily_average": "3 hrs 0 mins",
+				"languages": [%s],
+				"editors": [{"name": "VS Code", "total_seconds": 75900, "percent": 100, "text": "21 hrs 5 mins"}],
+				"projects": [],
+				"is_up_to_date": %t
+			}}`, strings.Join(items, ","), upToDate)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("WAKATIME_API_URL", server.URL+"/")
+	return &calls
+}
+
+func TestWakaTimeGetStats(t *testing.T) {
+	fakeWakaTime(t, 2, true)
+
+	stats, err := NewWakaTimeService(wakatimeTestKey).GetStats(context.Background())
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+
+	if stats.Username != "alice" || stats.Range != "last_7_days" {
+		t.Errorf("Username, Range = %q, %q, want alice, last_7_days", stats.Username, stats.Range)
+	}
+	if stats.TotalSeconds != 75900 || stats.DailyAverageSeconds != 10842.86 {
+		t.Errorf("TotalSeconds, DailyAverageSeconds = %v, %v, want 75900, 10842.86", stats.TotalSeconds, stats.DailyAverageSeconds)
+	}
+	if stats.HumanReadableTotal != "21 hrs 5 mins" {
+		t.Errorf("HumanReadableTotal = %q, want %q", stats.HumanReadableTotal, "21 hrs 5 mins")
+	}
+	if stats.Start == nil || stats.End == nil || !stats.End.After(*stats.Start) {
+		t.Errorf("Start, End = %v, %v, want the stats range", stats.Start, stats.End)
+	}
+	if !stats.IsUpToDate {
+		t.Error("IsUpToDate = false, want true")
+	}
+
+	wantLanguages := []WakaTimeItem{
+		{Name: "lang0", TotalSeconds: 120, Percent: 2, Text: "2 mins"},
+		{Name: "lang1", TotalSeconds: 60, Percent: 1, Text: "1 mins"},
+	}
+	if !reflect.DeepEqual(stats.Languages, wantLanguages) {
+		t.Errorf("Languages = %+v, want %+v", stats.Languages, wantLanguages)
+	}
+	if len(stats.Editors) != 1 || stats.Editors[0].Name != "VS Code" {
+		t.Errorf("Editors = %+v, want VS Code", stats.Editors)
+	}
+	if stats.Projects == nil || len(stats.Projects) != 0 {
+		t.Errorf("Projects = %#v, want an empty list", stats.Projects)
+	}
+}
+
+func TestWakaTimeGetStatsLimitsItems(t *testing.T) {
+	fakeWakaTime(t, wakatimeItemLimit+5, true)
+
+	stats, err := NewWakaTimeService(wakatimeTestKey).GetStats(context.Background())
+	if err != nil {
+		t.Fatalf("GetStats() error = %v", err)
+	}
+	if len(stats.Languages) != wakatimeItemLimit {
+		t.Fatalf("got %d languages, want %d", len(stats.Languages), wakatimeItemLimit)
+	}
+	if stats.Languages[0].Name != "lang0" {
+		t.Errorf("first language = %q, want the largest", stats.Languages[0].Name)
+	}
+}
+
+func TestWakaTimeGetStatsCaching(t *testing.T) {
+	tests := []struct {
+		name      string
+		upToDate  bool
+		wantCalls int32
+	}{
+		{"up-to-date stats are cached", true, 1},
+		{"stats still being calculated are not cached", false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := fakeWakaTime(t, 1, tt.upToDate)
+			service := NewWakaTimeService(wakatimeTestKey)
+
+			for i := 0; i < 2; i++ {
+				if _, err := service.GetStats(context.Background()); err != nil {
+					t.Fatalf("GetStats() error = %v", err)
+				}
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("upstream called %d times, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestWakaTimeInvalidKey(t *testing.T) {
+	fakeWakaTime(t, 1, true)
+
+	_, err := NewWakaTimeService("wrong_key").GetStats(context.Background())
+	if !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("GetStats() error = %v, want %v", err, ErrInvalidCredentials)
+	}
+}
+
+func TestWakaTimeGetUsername(t *testing.T) {
+	fakeWakaTime(t, 1, true)
+
+	username, err := NewWakaTimeService(wakatimeTestKey).GetUsername(context.Background())
+	if err != nil {
+		t.Fatalf("GetUsername() error = %v", err)
+	}
+	if username != "alice" {
+		t.Errorf("GetUsername() = %q, want alice", username)
+	}
+}
@@ -1,9 +1,10 @@
 "use client";
 
 import Link from "next/link";
-import { Globe, Linkedin, Github, FileText, Code } from "lucide-react";
+import { Globe, Linkedin, Github, FileText, Code, BadgeCheck } from "lucide-react";
 
 import type { User } from "@/types";
+import { cn } from "@/lib/utils";
 import { Avatar, AvatarFallback, AvatarImage } from "@/components/ui/avatar";
 import { Button } from "@/components/ui/button";
 import { Card, CardContent } from "@/components/ui/card";
@@ -88,12 +89,17 @@ export function ProfileHeader({
             <Button variant="outline" size="sm" asChild>
               <Link
                 href={`https://github.com/${user.githubUsername}`}
-                className="gap-1"
+                className={cn(
+                  "gap-1",
+                  !user.githubVerified && "border-dashed text-muted-foreground",
+                )}
+                title={user.githubVerified ? "Verified account" : "Unverified account"}
                 target="_blank"
                 rel="noreferrer"
               >
                 <Github className="size-3" />
                 GitHub
+                {user.githubVerified ? <BadgeCheck className="size-3" /> : null}
               </Link>
             </Button>
           ) : null}
@@ -101,12 +107,17 @@ export function ProfileHeader({
             <Button variant="outline" size="sm" asChild>
               <Link
                 href={`https://leetcode.com/${user.leetcodeUsername}`}
-                className="gap-1"
+                className={cn(
+                  "gap-1",
+                  !user.leetcodeVerified && "border-dashed text-muted-foreground",
+                )}
+                title={user.leetcodeVerified ? "Verified account" : "Unverified account"}
                 target="_blank"
                 rel="noreferrer"
               >
                 <Code className="size-3" />
                 LeetCode
+                {user.leetcodeVerified ? <BadgeCheck className="size-3" /> : null}
               </Link>
             </Button>
           ) : null}
@@ -13,17 +13,40 @@ export interface User {
   resume: string | null;
   role: string;
   githubUsername: string | null;
+  githubVerified: boolean;
   leetcodeUsername: string | null;
+  leetcodeVerified: boolean;
   linkedinUsername: string | null;
+  codeforcesHandle: string | null;
+  gitlabUsername: string | null;
+  gitlabUrl: string | null;
+  stackoverflowId: string | null;
+  timeZone: string | null;
+  visibility: ProfileVisibility;
+  allowMessages: AllowMessages;
   skills: string[];
   createdAt: string;
   updatedAt: string;
+  followsYou?: boolean;
+  youFollow?: boolean;
 }
 
+export type ProfileVisibility = "public" | "private";
+
+export type AllowMessages = "anyone" | "followers" | "mutuals";
+
 export interface FullUser extends User {
   projects: Project[];
   education: Education[];
   experience: Experience[];
+  endorsements: SkillEndorsements[];
+  recommendations?: Recommendation[];
+}
+
+export interface SkillEndorsements {
+  skill: string;
+  count: number;
+  topEndorsers: User[];
 }
 
 export interface UserQueryParams {
@@ -32,6 +55,8 @@ export interface UserQueryParams {
   search?: string;
   skill?: string;
   location?: string;
+  verified?: string;
+  sort?: "newest" | "endorsements";
 }
 
 // ============================================
@@ -47,8 +72,50 @@ export interface Project {
   description: string | null;
   image: string | null;
   url: string | null;
+  starCount: number;
+  createdAt: string;
+  updatedAt: string;
+  repository: ProjectRepository;
+}
+
+export interface ProjectComment {
+  id: string;
+  projectId: string;
+  userId: string;
+  parentId: string | null;
+  rootId: string | null;
+  body: string;
+  deletedAt: string | null;
   createdAt: string;
   updatedAt: string;
+  user?: User;
+  replies?: ProjectComment[];
+}
+
+export interface CommentsResponse {
+  comments: ProjectComment[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+export interface CreateCommentData {
+  body: string;
+  parentId?: string;
+}
+
+export type RepoStatus = "ok" | "renamed" | "not_found" | "invalid_url";
+
+export interface ProjectRepository {
+  fullName: string | null;
+  stars: number | null;
+  forks: number | null;
+  pushedAt: string | null;
+  license: string | null;
+  isArchived: boolean;
+  topics: string[] | null;
+  status: RepoStatus | null;
+  syncedAt: string | null;
 }
 
 export interface CreateProjectData {
@@ -152,6 +219,43 @@ export interface FollowResponse {
 
 export interface FollowStatusResponse {
   isFollowing: boolean;
+  followsYou: boolean;
+  isRequested: boolean;
+}
+
+export interface FollowRequest {
+  id: string;
+  requesterId: string;
+  targetId: string;
+  createdAt: string;
+  requester?: User;
+}
+
+export interface FollowRequestsResponse {
+  requests: FollowRequest[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+// ============================================
+// Verification types
+// ============================================
+
+export type VerificationProvider = "github" | "leetcode";
+
+export interface VerificationChallenge {
+  provider: VerificationProvider;
+  accountUsername: string;
+  token: string;
+  expiresAt: string;
+  instructions: string;
+}
+
+export interface VerificationStatus {
+  provider: VerificationProvider;
+  accountUsername: string;
+  verified: boolean;
 }
 
 // ============================================
@@ -167,6 +271,13 @@ export interface UpdateProfileData {
   githubUsername?: string;
   leetcodeUsername?: string;
   linkedinUsername?: string;
+  codeforcesHandle?: string;
+  gitlabUsername?: string;
+  gitlabUrl?: string;
+  stackoverflowId?: string;
+  timeZone?: string;
+  visibility?: ProfileVisibility;
+  allowMessages?: AllowMessages;
 }
 
 // ============================================
@@ -195,5 +306,380 @@ export interface LeetCodeStats {
   totalHard: number;
   acceptanceRate: number;
   ranking: number;
+  contributionPoints: number;
+  reputation: number;
   submissionCalendar: Record<string, number>;
+  recentSubmissions: LeetCodeSubmission[];
+  languages: LeetCodeLanguage[];
+  tags: LeetCodeTag[];
+}
+
+export interface LeetCodeSubmission {
+  id: string;
+  title: string;
+  titleSlug: string;
+  timestamp: number;
+  language: string;
+}
+
+export interface LeetCodeLanguage {
+  language: string;
+  problemsSolved: number;
+}
+
+export interface LeetCodeTag {
+  name: string;
+  slug: string;
+  level: "fundamental" | "intermediate" | "advanced";
+  problemsSolved: number;
+}
+
+export interface LeetCodeContestStats {
+  rating: number;
+  globalRanking: number;
+  totalParticipants: number;
+  topPercentage: number;
+  attendedContestsCount: number;
+  contestBadge: string | null;
+  history: LeetCodeContestResult[];
+  badges: LeetCodeBadge[];
+}
+
+export interface LeetCodeContestResult {
+  title: string;
+  startTime: number;
+  rating: number;
+  ranking: number;
+  trendDirection: string;
+  problemsSolved: number;
+  totalProblems: number;
+  finishTimeInSeconds: number;
+}
+
+export interface LeetCodeBadge {
+  id: string;
+  name: string;
+  displayName: string;
+  icon: string;
+  creationDate: string;
+}
+
+export interface CodeforcesStats {
+  handle: string;
+  avatar: string;
+  rating: number;
+  maxRating: number;
+  rank: string;
+  maxRank: string;
+  contribution: number;
+  totalSolved: number;
+  unratedSolved: number;
+  solvedByRating: { rating: number; count: number }[];
+}
+
+export interface CodeforcesRatingChange {
+  contestId: number;
+  contestName: string;
+  rank: number;
+  oldRating: number;
+  newRating: number;
+  updatedAt: number;
+}
+
+export interface GitLabProject {
+  id: number;
+  name: string;
+  fullName: string;
+  description: string | null;
+  url: string;
+  stars: number;
+  forks: number;
+  topics: string[];
+  lastActivityAt: string | null;
+}
+
+export interface StackOverflowStats {
+  userId: number;
+  displayName: string;
+  profileImage: string;
+  link: string;
+  reputation: number;
+  badges: { gold: number; silver: number; bronze: number };
+  topTags: { name: string; answerScore: number; answerCount: number }[];
+  topAnswers: {
+    answerId: number;
+    questionId: number;
+    title: string;
+    score: number;
+    isAccepted: boolean;
+    link: string;
+    createdAt: number;
+  }[];
+}
+
+export type ActivitySource = "github" | "gitlab" | "leetcode" | "codeforces";
+
+export interface ActivityDay {
+  date: string;
+  count: number;
+  sources: Partial<Record<ActivitySource, number>>;
+}
+
+export interface ActivityCalendar {
+  timeZone: string;
+  sources: ActivitySource[];
+  unavailableSources: ActivitySource[];
+  totalActivity: number;
+  totalsBySource: Partial<Record<ActivitySource, number>>;
+  weeks: { days: ActivityDay[] }[];
+}
+
+export interface ActivityStats {
+  timeZone: string;
+  sources: ActivitySource[];
+  unavailableSources: ActivitySource[];
+  currentStreak: number;
+  longestStreak: number;
+  activeDays: number;
+  totalActivity: number;
+  busiestWeekday: string | null;
+  average7Days: number;
+  average30Days: number;
+}
+
+export interface WakaTimeItem {
+  name: string;
+  totalSeconds: number;
+  percent: number;
+  text: string;
+}
+
+export interface WakaTimeStats {
+  username: string;
+  range: string;
+  start: string | null;
+  end: string | null;
+  totalSeconds: number;
+  dailyAverageSeconds: number;
+  humanReadableTotal: string;
+  humanReadableDailyAverage: string;
+  languages: WakaTimeItem[];
+  editors: WakaTimeItem[];
+  projects: WakaTimeItem[];
+  isUpToDate: boolean;
+}
+
+export interface ConnectedAccount {
+  provider: string;
+  accountName: string;
+  connectedAt: string;
+}
+
+export interface GitHubAuthorizeResponse {
+  authorizeUrl: string;
+}
+
+export interface GitHubCallbackData {
+  code: string;
+  state: string;
+}
+
+export interface LinkedInImportResult {
+  applied: boolean;
+  profile: Partial<Record<"firstName" | "lastName" | "headline", string>>;
+  experience: Experience[];
+  education: Education[];
+  skills: string[];
+  skipped: { experience: number; education: number; skills: number };
+}
+
+export interface OpenSourceRepository {
+  fullName: string;
+  url: string;
+  description: string | null;
+  stars: number;
+  primaryLanguage: string | null;
+  mergedPullRequests: number;
+  lastMergedAt: string | null;
+  pullRequests: {
+    title: string;
+    url: string;
+    number: number;
+    mergedAt: string | null;
+  }[];
+}
+
+export interface OpenSourceResponse {
+  repositories: OpenSourceRepository[];
+  total: number;
+  totalPullRequests: number;
+  page: number;
+  limit: number;
+}
+
+export type FeedEventType =
+  | "project_added"
+  | "new_role"
+  | "skills_updated"
+  | "follower_milestone";
+
+export interface FeedEvent {
+  id: string;
+  actorId: string;
+  type: FeedEventType;
+  payload: Record<string, unknown>;
+  createdAt: string;
+  actor?: User;
+}
+
+export interface FeedResponse {
+  events: FeedEvent[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+export type NotificationType =
+  | "follow"
+  | "endorsement"
+  | "project_star"
+  | "project_comment"
+  | "comment_reply"
+  | "follow_request"
+  | "follow_accepted"
+  | "recommendation_request"
+  | "recommendation_submitted";
+
+export interface Notification {
+  id: string;
+  userId: string;
+  actorId: string;
+  type: NotificationType;
+  entityId: string | null;
+  readAt: string | null;
+  createdAt: string;
+  actor?: User;
+}
+
+export interface NotificationResponse {
+  notifications: Notification[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+export interface NotificationPreference {
+  type: NotificationType;
+  enabled: boolean;
+}
+
+export interface FollowSuggestion {
+  user: User;
+  score: number;
+  reasons: string[];
+}
+
+export interface Block {
+  blockerId: string;
+  blockedId: string;
+  createdAt: string;
+  blocked?: User;
+}
+
+export interface Mute {
+  muterId: string;
+  mutedId: string;
+  createdAt: string;
+  muted?: User;
+}
+
+export interface BlocksResponse {
+  users: User[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+export interface MutesResponse {
+  users: User[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+export interface ConversationMember {
+  conversationId: string;
+  userId: string;
+  lastReadAt: string | null;
+  createdAt: string;
+  user?: User;
+}
+
+export interface Message {
+  id: string;
+  conversationId: string;
+  senderId: string;
+  body: string;
+  createdAt: string;
+}
+
+export interface Conversation {
+  id: string;
+  startedBy: string;
+  lastMessageAt: string;
+  createdAt: string;
+  members: ConversationMember[];
+  lastMessage?: Message;
+  unreadCount: number;
+}
+
+export interface ConversationsResponse {
+  conversations: Conversation[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+export interface MessagesResponse {
+  messages: Message[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+export interface StartConversationData {
+  username: string;
+  body: string;
+}
+
+export type RecommendationStatus = "requested" | "submitted" | "approved" | "hidden";
+
+export interface Recommendation {
+  id: string;
+  userId: string;
+  recommenderId: string;
+  experienceId: string | null;
+  status: RecommendationStatus;
+  message: string | null;
+  body: string | null;
+  submittedAt: string | null;
+  approvedAt: string | null;
+  createdAt: string;
+  updatedAt: string;
+  user?: User;
+  recommender?: User;
+  experience?: Experience;
+}
+
+export interface RecommendationsResponse {
+  recommendations: Recommendation[];
+  total: number;
+  page: number;
+  limit: number;
+}
+
+export interface RequestRecommendationData {
+  username: string;
+  experienceId?: string;
+  message?: string;
 }